@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/rs/zerolog/log"
+	"github.com/suwandre/arbiter/internal/scheduler"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+type ArbitrageHandler struct {
+	scheduler *scheduler.Scheduler
+}
+
+func NewArbitrageHandler(scheduler *scheduler.Scheduler) *ArbitrageHandler {
+	return &ArbitrageHandler{scheduler}
+}
+
+// Handles GET /v1/arb/:pair.
+func (h *ArbitrageHandler) GetOpportunities(c fiber.Ctx) error {
+	pairParam := c.Params("pair")
+
+	if pairParam == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "pair parameter is required",
+		})
+	}
+
+	pair, err := symbol.ParsePair(pairParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	log.Info().
+		Str("pair", pair.String()).
+		Msg("fetching arbitrage opportunities")
+
+	opportunities, ok := h.scheduler.GetOpportunities(pair)
+
+	if !ok {
+		log.Warn().Str("pair", pair.String()).Msg("pair not found in arbitrage cache")
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "pair not available, check configured pairs",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"pair":          pair,
+		"opportunities": opportunities,
+	})
+}
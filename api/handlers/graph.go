@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/rs/zerolog/log"
+	"github.com/suwandre/arbiter/internal/graph"
+	"github.com/suwandre/arbiter/internal/scheduler"
+)
+
+// defaultMaxHops/defaultNotionalUSD are used when max_hops/notional are
+// omitted from the query string.
+const (
+	defaultMaxHops     = 4
+	defaultNotionalUSD = 10_000
+)
+
+type GraphHandler struct {
+	scheduler *scheduler.Scheduler
+}
+
+func NewGraphHandler(scheduler *scheduler.Scheduler) *GraphHandler {
+	return &GraphHandler{scheduler}
+}
+
+// Handles GET /v1/graph/paths?from=&to=&max_hops=&notional=.
+// from/to are asset symbols (e.g. "USDT"); from == to searches for
+// arbitrage cycles. max_hops and notional default to defaultMaxHops and
+// defaultNotionalUSD when omitted.
+func (h *GraphHandler) FindPaths(c fiber.Ctx) error {
+	from := c.Query("from")
+	to := c.Query("to")
+	if from == "" || to == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "from and to query parameters are required",
+		})
+	}
+
+	maxHops, err := parseIntQuery(c, "max_hops", defaultMaxHops)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if maxHops <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "max_hops must be a positive integer",
+		})
+	}
+	if maxHops > graph.MaxHops {
+		maxHops = graph.MaxHops
+	}
+
+	notionalUSD, err := parseFloatQuery(c, "notional", defaultNotionalUSD)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	log.Info().Str("from", from).Str("to", to).Int("max_hops", maxHops).Msg("searching arbitrage paths")
+
+	paths, ok := h.scheduler.FindArbitragePaths(graph.Asset(from), graph.Asset(to), maxHops, notionalUSD)
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "arbitrage graph is not configured",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"from":  from,
+		"to":    to,
+		"paths": paths,
+	})
+}
+
+func parseIntQuery(c fiber.Ctx, param string, fallback int) (int, error) {
+	raw := c.Query(param)
+	if raw == "" {
+		return fallback, nil
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fiberQueryError(param, "an integer")
+	}
+	return v, nil
+}
+
+func parseFloatQuery(c fiber.Ctx, param string, fallback float64) (float64, error) {
+	raw := c.Query(param)
+	if raw == "" {
+		return fallback, nil
+	}
+
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fiberQueryError(param, "a number")
+	}
+	return v, nil
+}
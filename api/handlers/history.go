@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/rs/zerolog/log"
+	"github.com/suwandre/arbiter/internal/storage"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+type HistoryHandler struct {
+	store storage.Store
+}
+
+func NewHistoryHandler(store storage.Store) *HistoryHandler {
+	return &HistoryHandler{store}
+}
+
+// Handles GET /v1/scores/:pair/history?from=&to=&interval=.
+// from/to are Unix seconds, interval is a Go duration string (e.g. "1h").
+func (h *HistoryHandler) GetScoreHistory(c fiber.Ctx) error {
+	pairParam := c.Params("pair")
+	if pairParam == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "pair parameter is required",
+		})
+	}
+
+	pair, err := symbol.ParsePair(pairParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	from, to, interval, err := parseHistoryRange(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	buckets, err := h.store.ScoreHistory(c.Context(), pair.String(), from, to, interval)
+	if err != nil {
+		log.Error().Err(err).Str("pair", pair.String()).Msg("score history query failed")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to query score history",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"pair":    pair,
+		"buckets": buckets,
+	})
+}
+
+// Handles GET /v1/funding/:pair/history?from=&to=&interval=.
+func (h *HistoryHandler) GetFundingHistory(c fiber.Ctx) error {
+	pairParam := c.Params("pair")
+	if pairParam == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "pair parameter is required",
+		})
+	}
+
+	pair, err := symbol.ParsePair(pairParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	from, to, interval, err := parseHistoryRange(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	buckets, err := h.store.FundingHistory(c.Context(), pair.String(), from, to, interval)
+	if err != nil {
+		log.Error().Err(err).Str("pair", pair.String()).Msg("funding history query failed")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to query funding history",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"pair":    pair,
+		"buckets": buckets,
+	})
+}
+
+// parseHistoryRange reads and validates the shared from/to/interval query
+// params used by both history endpoints.
+func parseHistoryRange(c fiber.Ctx) (from, to time.Time, interval time.Duration, err error) {
+	fromSec, err := strconv.ParseInt(c.Query("from"), 10, 64)
+	if err != nil {
+		return time.Time{}, time.Time{}, 0, fiberQueryError("from", "unix seconds")
+	}
+
+	toSec, err := strconv.ParseInt(c.Query("to"), 10, 64)
+	if err != nil {
+		return time.Time{}, time.Time{}, 0, fiberQueryError("to", "unix seconds")
+	}
+
+	interval, err = time.ParseDuration(c.Query("interval", "1h"))
+	if err != nil {
+		return time.Time{}, time.Time{}, 0, fiberQueryError("interval", "a Go duration string, e.g. 1h")
+	}
+
+	return time.Unix(fromSec, 0), time.Unix(toSec, 0), interval, nil
+}
+
+func fiberQueryError(param, want string) error {
+	return fiber.NewError(fiber.StatusBadRequest, "invalid "+param+" parameter, expected "+want)
+}
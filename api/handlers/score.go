@@ -3,7 +3,10 @@ package handlers
 import (
 	"github.com/gofiber/fiber/v3"
 	"github.com/rs/zerolog/log"
+	"github.com/suwandre/arbiter/internal/models"
 	"github.com/suwandre/arbiter/internal/scheduler"
+	"github.com/suwandre/arbiter/internal/scorer"
+	"github.com/suwandre/arbiter/internal/symbol"
 )
 
 type ScoreHandler struct {
@@ -16,29 +19,62 @@ func NewScoreHandler(scheduler *scheduler.Scheduler) *ScoreHandler {
 
 // Handles GET /scores/:pair.
 func (h *ScoreHandler) GetScores(c fiber.Ctx) error {
-	pair := c.Params("pair")
+	pairParam := c.Params("pair")
 
-	if pair == "" {
+	if pairParam == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": "pair parameter is required",
 		})
 	}
 
+	pair, err := symbol.ParsePair(pairParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
 	log.Info().
-		Str("pair", pair).
+		Str("pair", pair.String()).
 		Msg("fetching scores")
 
 	scores, ok := h.scheduler.GetScores(pair)
 
 	if !ok {
-		log.Warn().Str("pair", pair).Msg("pair not found in cache")
+		log.Warn().Str("pair", pair.String()).Msg("pair not found in cache")
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"error": "pair not available, check configured pairs",
 		})
 	}
 
+	strategyParam := c.Query("strategy")
+	strategy, err := scorer.ParseStrategy(strategyParam, c.Query("weights"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	// Re-rank a copy of the cached raw-metric snapshot under the requested
+	// strategy, rather than mutating the scheduler's shared cache.
+	snapshot := cloneScores(scores)
+	strategy.Apply(snapshot)
+	scorer.RankScores(snapshot)
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"pair":   pair,
-		"scores": scores,
+		"scores": snapshot,
 	})
 }
+
+// cloneScores makes a shallow copy of each ExchangeScore so a per-request
+// ScoringStrategy can rewrite CompositeScore without racing the scheduler's
+// background refresh goroutine.
+func cloneScores(scores []*models.ExchangeScore) []*models.ExchangeScore {
+	clones := make([]*models.ExchangeScore, len(scores))
+	for i, s := range scores {
+		clone := *s
+		clones[i] = &clone
+	}
+	return clones
+}
@@ -4,12 +4,25 @@ import (
 	"github.com/gofiber/fiber/v3"
 	"github.com/suwandre/arbiter/api/handlers"
 	"github.com/suwandre/arbiter/internal/scheduler"
+	"github.com/suwandre/arbiter/internal/storage"
 )
 
-func SetupRoutes(app *fiber.App, scheduler *scheduler.Scheduler) {
+// SetupRoutes registers all v1 routes. store may be nil, in which case the
+// history endpoints are not registered.
+func SetupRoutes(app *fiber.App, scheduler *scheduler.Scheduler, store storage.Store) {
 	scoreHandler := handlers.NewScoreHandler(scheduler)
+	arbitrageHandler := handlers.NewArbitrageHandler(scheduler)
+	graphHandler := handlers.NewGraphHandler(scheduler)
 
 	v1 := app.Group("/v1")
 
 	v1.Get("/scores/:pair", scoreHandler.GetScores)
+	v1.Get("/arb/:pair", arbitrageHandler.GetOpportunities)
+	v1.Get("/graph/paths", graphHandler.FindPaths)
+
+	if store != nil {
+		historyHandler := handlers.NewHistoryHandler(store)
+		v1.Get("/scores/:pair/history", historyHandler.GetScoreHistory)
+		v1.Get("/funding/:pair/history", historyHandler.GetFundingHistory)
+	}
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"os"
 	"os/signal"
 	"syscall"
@@ -11,8 +12,13 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/suwandre/arbiter/api"
 	"github.com/suwandre/arbiter/config"
+	"github.com/suwandre/arbiter/internal/arbitrage"
 	"github.com/suwandre/arbiter/internal/exchange"
+	"github.com/suwandre/arbiter/internal/graph"
+	"github.com/suwandre/arbiter/internal/scheduler"
 	"github.com/suwandre/arbiter/internal/scorer"
+	"github.com/suwandre/arbiter/internal/storage"
+	"github.com/suwandre/arbiter/internal/symbol"
 )
 
 func main() {
@@ -26,25 +32,55 @@ func main() {
 
 	// ── 3. Exchange adapters ──────────────────────────────────────
 	exchanges := []exchange.Exchange{
-		exchange.NewBinanceAdapter(cfg.BinanceKey),
-		exchange.NewBybitAdapter(cfg.BybitKey),
+		exchange.NewBinanceAdapter(exchange.BinanceConfig{
+			APIKey:     cfg.BinanceKey,
+			APISecret:  cfg.BinanceSecret,
+			UseTestnet: cfg.BinanceTestnet,
+		}),
+		exchange.NewBybitAdapter(exchange.BybitConfig{
+			APIKey:     cfg.BybitKey,
+			UseTestnet: cfg.BybitTestnet,
+		}),
+		exchange.NewMexcAdapter(cfg.MexcKey),
 	}
 	log.Info().Int("count", len(exchanges)).Msg("exchange adapters initialized")
 
-	// ── 4. Scorer ─────────────────────────────────────────────────
+	// ── 4. Persistent store ───────────────────────────────────────
+	var store storage.Store
+	if sqliteStore, err := storage.NewSQLiteStore(cfg.StorePath); err != nil {
+		log.Error().Err(err).Msg("failed to open score store, history endpoints will be disabled")
+	} else {
+		store = sqliteStore
+	}
+
+	// ── 5. Scorer + scheduler ──────────────────────────────────────
+	var pairs []symbol.Pair
+	for _, raw := range cfg.Pairs {
+		pair, err := symbol.ParsePair(raw)
+		if err != nil {
+			log.Warn().Err(err).Str("pair", raw).Msg("skipping unparseable configured pair")
+			continue
+		}
+		pairs = append(pairs, pair)
+	}
+
 	sc := scorer.NewScorer(exchanges)
+	detector := arbitrage.NewDetector(cfg.TakerFeesBps)
+	arbGraph := graph.NewGraph(cfg.TakerFeesBps)
+	sched := scheduler.NewScheduler(sc, exchanges, pairs, cfg.RefreshInterval, store, detector, arbGraph)
+	sched.Start(context.Background())
 
-	// ── 5. Fiber app ──────────────────────────────────────────────
+	// ── 6. Fiber app ──────────────────────────────────────────────
 	app := fiber.New(fiber.Config{
 		AppName:      "Arbiter",
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 10 * time.Second,
 	})
 
-	// ── 6. Routes ─────────────────────────────────────────────────
-	api.SetupRoutes(app, sc)
+	// ── 7. Routes ─────────────────────────────────────────────────
+	api.SetupRoutes(app, sched, store)
 
-	// ── 7. Graceful shutdown ──────────────────────────────────────
+	// ── 8. Graceful shutdown ──────────────────────────────────────
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
@@ -52,12 +88,19 @@ func main() {
 		<-quit
 		log.Info().Msg("shutdown signal received")
 
+		sched.Stop()
+		if store != nil {
+			if err := store.Close(); err != nil {
+				log.Error().Err(err).Msg("error closing score store")
+			}
+		}
+
 		if err := app.Shutdown(); err != nil {
 			log.Error().Err(err).Msg("error during shutdown")
 		}
 	}()
 
-	// ── 8. Start server ───────────────────────────────────────────
+	// ── 9. Start server ───────────────────────────────────────────
 	log.Info().Str("port", cfg.AppPort).Msg("starting server")
 
 	if err := app.Listen(":" + cfg.AppPort); err != nil {
@@ -3,15 +3,25 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	AppPort    string
-	BinanceKey string
-	BybitKey   string
-	MexcKey    string
+	AppPort         string
+	BinanceKey      string
+	BinanceSecret   string
+	BinanceTestnet  bool
+	BybitKey        string
+	BybitTestnet    bool
+	MexcKey         string
+	Pairs           []string
+	RefreshInterval time.Duration
+	StorePath       string
+	TakerFeesBps    map[string]float64
 }
 
 func Load() *Config {
@@ -20,10 +30,21 @@ func Load() *Config {
 	}
 
 	return &Config{
-		AppPort:    getEnv("APP_PORT", "3000"),
-		BinanceKey: getEnv("BINANCE_API_KEY", ""),
-		BybitKey:   getEnv("BYBIT_API_KEY", ""),
-		MexcKey:    getEnv("MEXC_API_KEY", ""),
+		AppPort:         getEnv("APP_PORT", "3000"),
+		BinanceKey:      getEnv("BINANCE_API_KEY", ""),
+		BinanceSecret:   getEnv("BINANCE_API_SECRET", ""),
+		BinanceTestnet:  getEnvBool("ARBITER_BINANCE_TESTNET", false),
+		BybitKey:        getEnv("BYBIT_API_KEY", ""),
+		BybitTestnet:    getEnvBool("ARBITER_BYBIT_TESTNET", false),
+		MexcKey:         getEnv("MEXC_API_KEY", ""),
+		Pairs:           getEnvList("ARBITER_PAIRS", []string{"BTC/USDT"}),
+		RefreshInterval: getEnvDuration("ARBITER_REFRESH_INTERVAL_SEC", 30*time.Second),
+		StorePath:       getEnv("ARBITER_STORE_PATH", "arbiter.db"),
+		TakerFeesBps: getEnvFeeMap("ARBITER_TAKER_FEES_BPS", map[string]float64{
+			"binance": 4,
+			"bybit":   5.5,
+			"mexc":    6,
+		}),
 	}
 }
 
@@ -33,3 +54,79 @@ func getEnv(key string, fallback string) string {
 	}
 	return fallback
 }
+
+// getEnvList reads a comma-separated env var, falling back when unset.
+func getEnvList(key string, fallback []string) []string {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return fallback
+	}
+
+	parts := strings.Split(value, ",")
+	pairs := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if trimmed := strings.TrimSpace(p); trimmed != "" {
+			pairs = append(pairs, trimmed)
+		}
+	}
+	return pairs
+}
+
+// getEnvFeeMap reads a comma-separated "exchange:bps" list, e.g.
+// "binance:4,bybit:5.5", falling back when unset. Entries that fail to
+// parse are skipped with a log line rather than failing config load.
+func getEnvFeeMap(key string, fallback map[string]float64) map[string]float64 {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return fallback
+	}
+
+	fees := make(map[string]float64)
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 2)
+		if len(parts) != 2 {
+			log.Printf("invalid %s entry %q, expected exchange:bps", key, entry)
+			continue
+		}
+
+		bps, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil {
+			log.Printf("invalid %s entry %q: %v", key, entry, err)
+			continue
+		}
+		fees[strings.TrimSpace(parts[0])] = bps
+	}
+	return fees
+}
+
+// getEnvBool reads a "true"/"false" env var, falling back when unset or
+// invalid.
+func getEnvBool(key string, fallback bool) bool {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("invalid value for %s, using default: %v", key, err)
+		return fallback
+	}
+	return parsed
+}
+
+// getEnvDuration reads an env var holding a whole number of seconds,
+// falling back when unset or invalid.
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("invalid value for %s, using default: %v", key, err)
+		return fallback
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,180 @@
+package arbitrage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// Kind identifies what a detected Opportunity is exploiting.
+type Kind string
+
+const (
+	KindSpread  Kind = "spread"  // buy low on one venue, sell high on another
+	KindFunding Kind = "funding" // long the negative-funding venue, short the positive one
+)
+
+// Opportunity is one ranked cross-exchange arbitrage candidate.
+type Opportunity struct {
+	Pair          symbol.Pair `json:"pair"`
+	Kind          Kind        `json:"kind"`
+	LongExchange  string      `json:"long_exchange"`  // venue to buy/go long on
+	ShortExchange string      `json:"short_exchange"` // venue to sell/go short on
+	SizedNotional float64     `json:"sized_notional_usd"`
+	NetPnLUSD     float64     `json:"net_pnl_usd"`
+	NetPnLBps     float64     `json:"net_pnl_bps"`
+	Detail        string      `json:"detail"`
+	DetectedAt    time.Time   `json:"detected_at"`
+}
+
+// fundingIntervalHours is the standard perpetual-futures funding cadence
+// shared by Binance, Bybit, and MEXC for the pairs arbiter tracks.
+const fundingIntervalHours = 8
+
+// Detector computes cross-exchange arbitrage opportunities from a pair's
+// ExchangeScores, net of each exchange's configured taker fee.
+type Detector struct {
+	takerFeesBps map[string]float64
+}
+
+// NewDetector builds a Detector. takerFeesBps maps exchange name (as
+// returned by Exchange.Name()) to its taker fee in basis points; exchanges
+// missing an entry are treated as zero-fee.
+func NewDetector(takerFeesBps map[string]float64) *Detector {
+	return &Detector{takerFeesBps: takerFeesBps}
+}
+
+// Detect returns ranked spread and funding-rate arbitrage opportunities
+// across scores, highest NetPnLUSD first. scores must all be for the same
+// pair (the caller is expected to pass one scheduler refresh's worth of
+// ExchangeScores for a single pair).
+func (d *Detector) Detect(pair symbol.Pair, scores []*models.ExchangeScore) []Opportunity {
+	var opportunities []Opportunity
+
+	opportunities = append(opportunities, d.detectSpreadArbs(pair, scores)...)
+
+	if fundingArb, ok := d.detectFundingArb(pair, scores); ok {
+		opportunities = append(opportunities, fundingArb)
+	}
+
+	rankByPnL(opportunities)
+	return opportunities
+}
+
+// detectSpreadArbs checks every ordered pair of exchanges (A, B) and emits
+// an opportunity whenever buying on A's ask and selling on B's bid is
+// profitable net of both exchanges' taker fees.
+func (d *Detector) detectSpreadArbs(pair symbol.Pair, scores []*models.ExchangeScore) []Opportunity {
+	var opportunities []Opportunity
+
+	for _, a := range scores {
+		for _, b := range scores {
+			if a.Exchange == b.Exchange {
+				continue
+			}
+			if a.RawAsk <= 0 || b.RawBid <= 0 {
+				continue
+			}
+			if a.RawAsk >= b.RawBid {
+				continue // no crossing spread before fees
+			}
+
+			buyCost := a.RawAsk * (1 + d.feeRate(a.Exchange))
+			sellProceeds := b.RawBid * (1 - d.feeRate(b.Exchange))
+			if sellProceeds <= buyCost {
+				continue // fees erase the edge
+			}
+
+			sizedNotional := min(a.RawAskDepth, b.RawBidDepth)
+			pnlRatio := (sellProceeds - buyCost) / buyCost
+
+			opportunities = append(opportunities, Opportunity{
+				Pair:          pair,
+				Kind:          KindSpread,
+				LongExchange:  a.Exchange,
+				ShortExchange: b.Exchange,
+				SizedNotional: sizedNotional,
+				NetPnLUSD:     sizedNotional * pnlRatio,
+				NetPnLBps:     pnlRatio * 10000,
+				Detail:        "buy " + a.Exchange + " ask, sell " + b.Exchange + " bid",
+				DetectedAt:    time.Now(),
+			})
+		}
+	}
+
+	return opportunities
+}
+
+// detectFundingArb pairs the most-negative-funding exchange (long side,
+// collects funding) against the most-positive-funding exchange (short
+// side, collects funding), estimating carry over one funding interval.
+func (d *Detector) detectFundingArb(pair symbol.Pair, scores []*models.ExchangeScore) (Opportunity, bool) {
+	if len(scores) < 2 {
+		return Opportunity{}, false
+	}
+
+	long, short := scores[0], scores[0]
+	for _, s := range scores {
+		if s.FundingRate < long.FundingRate {
+			long = s
+		}
+		if s.FundingRate > short.FundingRate {
+			short = s
+		}
+	}
+
+	if long.Exchange == short.Exchange {
+		return Opportunity{}, false
+	}
+
+	carryPerInterval := short.FundingRate - long.FundingRate
+	if carryPerInterval <= 0 {
+		return Opportunity{}, false
+	}
+
+	sizedNotional := min(long.RawAskDepth, short.RawBidDepth)
+	netCarry := carryPerInterval - d.feeRate(long.Exchange) - d.feeRate(short.Exchange)
+	if netCarry <= 0 {
+		return Opportunity{}, false
+	}
+
+	return Opportunity{
+		Pair:          pair,
+		Kind:          KindFunding,
+		LongExchange:  long.Exchange,
+		ShortExchange: short.Exchange,
+		SizedNotional: sizedNotional,
+		NetPnLUSD:     sizedNotional * netCarry,
+		NetPnLBps:     netCarry * 10000,
+		Detail:        "long " + long.Exchange + " / short " + short.Exchange + ", ~" + formatHours(fundingIntervalHours) + " carry",
+		DetectedAt:    time.Now(),
+	}, true
+}
+
+// feeRate returns the taker fee for exchangeName as a fraction (not bps).
+func (d *Detector) feeRate(exchangeName string) float64 {
+	return d.takerFeesBps[exchangeName] / 10000
+}
+
+// rankByPnL sorts opportunities in-place, highest NetPnLUSD first.
+func rankByPnL(opportunities []Opportunity) {
+	sort.Slice(opportunities, func(i, j int) bool {
+		return opportunities[i].NetPnLUSD > opportunities[j].NetPnLUSD
+	})
+}
+
+func min(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func formatHours(h int) string {
+	if h == 24 {
+		return "1d"
+	}
+	return time.Duration(h * int(time.Hour)).String()
+}
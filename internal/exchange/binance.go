@@ -9,22 +9,123 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/suwandre/arbiter/internal/httpx"
 	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// binanceWeightBudget/binanceWeightWindow mirror Binance's published IP
+// rate limit: a budget of request weight units replenished every window.
+const (
+	binanceWeightBudget = 1200
+	binanceWeightWindow = time.Minute
+)
+
+// Binance per-endpoint request weights, as published in their API docs.
+const (
+	weightFundingRate    = 1
+	weightSpread         = 1
+	weightOrderBookDepth = 2 // limit=5
+	weightDepthSnapshot  = 5 // limit=100
+	weightAccountInfo    = 5
+	weightPositionRisk   = 5
+	weightPlaceOrder     = 1
+	weightKlines         = 1 // limit<=100
+)
+
+// Default and testnet REST/stream base URLs, mirroring the UseTestnet
+// toggle pattern used by go-binance.
+const (
+	binanceBaseURL           = "https://fapi.binance.com"
+	binanceStreamBaseURLProd = "wss://fstream.binance.com/stream"
+	binanceTestnetBaseURL    = "https://testnet.binancefuture.com"
+	binanceTestnetStreamURL  = "wss://stream.binancefuture.com/stream"
 )
 
 // BinanceAdapter holds any config/state specific to Binance.
 type BinanceAdapter struct {
-	apiKey     string
-	httpClient *http.Client
+	apiKey        string
+	apiSecret     string
+	baseURL       string
+	streamBaseURL string
+	httpClient    *http.Client
+	codec         symbol.SymbolCodec
+}
+
+// BinanceConfig configures a BinanceAdapter. The zero value is meaningful:
+// every field defaults sensibly, so `NewBinanceAdapter(BinanceConfig{APIKey:
+// key, APISecret: secret})` is enough for the common mainnet case.
+type BinanceConfig struct {
+	APIKey    string
+	APISecret string // only required for PrivateExchange methods (account/positions/orders)
+
+	// BaseURL overrides the REST base URL. Defaults to the mainnet futures
+	// API, or UseTestnet's URL if BaseURL is left empty and UseTestnet is
+	// set.
+	BaseURL string
+
+	// UseTestnet points BaseURL and the WebSocket stream base URL at
+	// Binance's futures testnet instead of mainnet, ignored if BaseURL is
+	// set explicitly. Use this (or a local recording proxy via BaseURL) to
+	// exercise signed order flows without risking real funds.
+	UseTestnet bool
+
+	// HTTPClient overrides the adapter's HTTP client. Defaults to
+	// defaultBinanceHTTPClient() (weight-aware rate limiting and retries)
+	// if nil.
+	HTTPClient *http.Client
 }
 
-// Constructor function. Creates a new BinanceAdapter instance.
-func NewBinanceAdapter(apiKey string) *BinanceAdapter {
+// NewBinanceAdapter builds a BinanceAdapter from cfg, defaulting BaseURL,
+// the stream base URL, and HTTPClient when left zero-valued.
+func NewBinanceAdapter(cfg BinanceConfig) *BinanceAdapter {
+	baseURL := cfg.BaseURL
+	streamBaseURL := binanceStreamBaseURLProd
+	if baseURL == "" {
+		if cfg.UseTestnet {
+			baseURL = binanceTestnetBaseURL
+			streamBaseURL = binanceTestnetStreamURL
+		} else {
+			baseURL = binanceBaseURL
+		}
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = defaultBinanceHTTPClient()
+	}
+
 	return &BinanceAdapter{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		apiKey:        cfg.APIKey,
+		apiSecret:     cfg.APISecret,
+		baseURL:       baseURL,
+		streamBaseURL: streamBaseURL,
+		httpClient:    httpClient,
+		codec:         symbol.ConcatCodec{},
+	}
+}
+
+// defaultBinanceHTTPClient builds an http.Client whose transport reserves
+// capacity against a WeightedLimiter sized to Binance's published IP budget
+// and retries 5xx/429 responses with jittered backoff, so adapters built
+// via NewBinanceAdapter don't need to reimplement rate-limit handling.
+func defaultBinanceHTTPClient() *http.Client {
+	limiter := httpx.NewWeightedLimiter(binanceWeightBudget, binanceWeightWindow)
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: httpx.NewRetryTransport(nil, limiter),
+	}
+}
+
+// NewBinanceAdapterForTesting builds a BinanceAdapter backed by client
+// instead of the default HTTP client, so the conformance suite can point it
+// at an httptest.Server.
+func NewBinanceAdapterForTesting(client *http.Client) *BinanceAdapter {
+	return &BinanceAdapter{
+		baseURL:       binanceBaseURL,
+		streamBaseURL: binanceStreamBaseURLProd,
+		httpClient:    client,
+		codec:         symbol.ConcatCodec{},
 	}
 }
 
@@ -33,8 +134,9 @@ func (b *BinanceAdapter) Name() string {
 }
 
 // Fetches the current funding rate for a perpetual futures pair.
-func (b *BinanceAdapter) GetFundingRate(ctx context.Context, pair string) (*models.FundingRate, error) {
-	url := fmt.Sprintf("https://fapi.binance.com/fapi/v1/premiumIndex?symbol=%s", pair)
+func (b *BinanceAdapter) GetFundingRate(ctx context.Context, pair symbol.Pair) (*models.FundingRate, error) {
+	ctx = httpx.WithWeight(ctx, weightFundingRate)
+	url := fmt.Sprintf("%s/fapi/v1/premiumIndex?symbol=%s", b.baseURL, b.codec.Encode(pair))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -81,8 +183,9 @@ func (b *BinanceAdapter) GetFundingRate(ctx context.Context, pair string) (*mode
 }
 
 // Fetches the current best bid/ask and calculates spread.
-func (b *BinanceAdapter) GetSpread(ctx context.Context, pair string) (*models.Spread, error) {
-	url := fmt.Sprintf("https://fapi.binance.com/fapi/v1/ticker/bookTicker?symbol=%s", pair)
+func (b *BinanceAdapter) GetSpread(ctx context.Context, pair symbol.Pair) (*models.Spread, error) {
+	ctx = httpx.WithWeight(ctx, weightSpread)
+	url := fmt.Sprintf("%s/fapi/v1/ticker/bookTicker?symbol=%s", b.baseURL, b.codec.Encode(pair))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -113,8 +216,14 @@ func (b *BinanceAdapter) GetSpread(ctx context.Context, pair string) (*models.Sp
 		return nil, fmt.Errorf("failed to parse binance spread response: %w", err)
 	}
 
-	bid, _ := strconv.ParseFloat(raw.BidPrice, 64)
-	ask, _ := strconv.ParseFloat(raw.AskPrice, 64)
+	bid, err := strconv.ParseFloat(raw.BidPrice, 64)
+	if err != nil {
+		return nil, fmt.Errorf("binance spread: failed to parse bid price %q: %w", raw.BidPrice, err)
+	}
+	ask, err := strconv.ParseFloat(raw.AskPrice, 64)
+	if err != nil {
+		return nil, fmt.Errorf("binance spread: failed to parse ask price %q: %w", raw.AskPrice, err)
+	}
 
 	return &models.Spread{
 		Exchange: "binance",
@@ -126,8 +235,9 @@ func (b *BinanceAdapter) GetSpread(ctx context.Context, pair string) (*models.Sp
 }
 
 // GetOrderBookDepth fetches top-of-book liquidity depth
-func (b *BinanceAdapter) GetOrderBookDepth(ctx context.Context, pair string) (*models.OrderBookDepth, error) {
-	url := fmt.Sprintf("https://fapi.binance.com/fapi/v1/depth?symbol=%s&limit=5", pair)
+func (b *BinanceAdapter) GetOrderBookDepth(ctx context.Context, pair symbol.Pair) (*models.OrderBookDepth, error) {
+	ctx = httpx.WithWeight(ctx, weightOrderBookDepth)
+	url := fmt.Sprintf("%s/fapi/v1/depth?symbol=%s&limit=5", b.baseURL, b.codec.Encode(pair))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -158,24 +268,39 @@ func (b *BinanceAdapter) GetOrderBookDepth(ctx context.Context, pair string) (*m
 		return nil, fmt.Errorf("failed to parse depth response: %w", err)
 	}
 
-	bidDepth := sumDepth(raw.Bids)
-	askDepth := sumDepth(raw.Asks)
+	bids, err := parseLevels(raw.Bids)
+	if err != nil {
+		return nil, fmt.Errorf("binance depth: %w", err)
+	}
+	asks, err := parseLevels(raw.Asks)
+	if err != nil {
+		return nil, fmt.Errorf("binance depth: %w", err)
+	}
 
 	return &models.OrderBookDepth{
 		Exchange: "binance",
 		Pair:     pair,
-		BidDepth: bidDepth,
-		AskDepth: askDepth,
+		Bids:     bids,
+		Asks:     asks,
 	}, nil
 }
 
-// Private helper â€” sums total quote value across order book levels.
-func sumDepth(levels [][]string) float64 {
-	total := 0.0
-	for _, level := range levels {
-		price, _ := strconv.ParseFloat(level[0], 64)
-		qty, _ := strconv.ParseFloat(level[1], 64)
-		total += price * qty
+// parseLevels converts [price, quantity] string pairs, as returned by both
+// Binance and Bybit, into canonical price levels. It returns an error if
+// any level's price or quantity fails to parse, rather than silently
+// feeding a zero value into depth and slippage scoring.
+func parseLevels(raw [][]string) ([]models.PriceLevel, error) {
+	levels := make([]models.PriceLevel, 0, len(raw))
+	for _, level := range raw {
+		price, err := strconv.ParseFloat(level[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse price %q: %w", level[0], err)
+		}
+		qty, err := strconv.ParseFloat(level[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse quantity %q: %w", level[1], err)
+		}
+		levels = append(levels, models.PriceLevel{Price: price, Qty: qty})
 	}
-	return total
+	return levels, nil
 }
@@ -0,0 +1,137 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/suwandre/arbiter/internal/httpx"
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// GetKlines fetches OHLC candles for pair at interval via /fapi/v1/klines,
+// narrowed by opts (start/end time, result limit).
+func (b *BinanceAdapter) GetKlines(ctx context.Context, pair symbol.Pair, interval models.KlineInterval, opts ...models.KlineOption) ([]models.Kline, error) {
+	ctx = httpx.WithWeight(ctx, weightKlines)
+	query := models.ApplyKlineOptions(opts...)
+
+	url := fmt.Sprintf("%s/fapi/v1/klines?symbol=%s&interval=%s", b.baseURL, b.codec.Encode(pair), interval)
+	if !query.StartTime.IsZero() {
+		url += fmt.Sprintf("&startTime=%d", query.StartTime.UnixMilli())
+	}
+	if !query.EndTime.IsZero() {
+		url += fmt.Sprintf("&endTime=%d", query.EndTime.UnixMilli())
+	}
+	if query.Limit > 0 {
+		url += fmt.Sprintf("&limit=%d", query.Limit)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("binance klines: failed to build request: %w", err)
+	}
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("binance klines request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("binance klines: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	// Binance returns each candle as a heterogeneous JSON array rather than
+	// an object, so each row is decoded positionally.
+	var raw [][]interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse binance klines response: %w", err)
+	}
+
+	return parseKlines(raw)
+}
+
+// parseKlines converts Binance's [open_time, open, high, low, close,
+// volume, close_time, ...] rows into canonical Klines.
+func parseKlines(raw [][]interface{}) ([]models.Kline, error) {
+	klines := make([]models.Kline, 0, len(raw))
+	for _, row := range raw {
+		if len(row) < 7 {
+			return nil, fmt.Errorf("binance klines: malformed row with %d fields", len(row))
+		}
+
+		openTime, err := klineField(row[0])
+		if err != nil {
+			return nil, fmt.Errorf("binance klines: open time: %w", err)
+		}
+		open, err := klineFieldFloat(row[1])
+		if err != nil {
+			return nil, fmt.Errorf("binance klines: open: %w", err)
+		}
+		high, err := klineFieldFloat(row[2])
+		if err != nil {
+			return nil, fmt.Errorf("binance klines: high: %w", err)
+		}
+		low, err := klineFieldFloat(row[3])
+		if err != nil {
+			return nil, fmt.Errorf("binance klines: low: %w", err)
+		}
+		closePrice, err := klineFieldFloat(row[4])
+		if err != nil {
+			return nil, fmt.Errorf("binance klines: close: %w", err)
+		}
+		volume, err := klineFieldFloat(row[5])
+		if err != nil {
+			return nil, fmt.Errorf("binance klines: volume: %w", err)
+		}
+		closeTime, err := klineField(row[6])
+		if err != nil {
+			return nil, fmt.Errorf("binance klines: close time: %w", err)
+		}
+
+		klines = append(klines, models.Kline{
+			OpenTime:  time.UnixMilli(int64(openTime)),
+			Open:      open,
+			High:      high,
+			Low:       low,
+			Close:     closePrice,
+			Volume:    volume,
+			CloseTime: time.UnixMilli(int64(closeTime)),
+		})
+	}
+	return klines, nil
+}
+
+// klineField extracts a numeric field (Binance sends timestamps as JSON
+// numbers) decoded by encoding/json into an interface{} as float64.
+func klineField(v interface{}) (float64, error) {
+	f, ok := v.(float64)
+	if !ok {
+		return 0, fmt.Errorf("expected number, got %T", v)
+	}
+	return f, nil
+}
+
+// klineFieldFloat extracts a price/volume field, which Binance sends as a
+// JSON string to preserve decimal precision.
+func klineFieldFloat(v interface{}) (float64, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("expected string, got %T", v)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %q: %w", s, err)
+	}
+	return f, nil
+}
@@ -0,0 +1,296 @@
+package exchange
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/suwandre/arbiter/internal/httpx"
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// recvWindowMs is the tolerance Binance allows between a signed request's
+// timestamp and the server's clock before rejecting it.
+const recvWindowMs = 5000
+
+// sign returns the HMAC-SHA256 signature (hex-encoded) of params' encoded
+// query string, using apiSecret as the key, per Binance's signed-endpoint
+// convention.
+func (b *BinanceAdapter) sign(params url.Values) string {
+	mac := hmac.New(sha256.New, []byte(b.apiSecret))
+	mac.Write([]byte(params.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signedQuery stamps params with timestamp and recvWindow and appends the
+// HMAC-SHA256 signature computed over the result, as required by every
+// Binance endpoint under /fapi/v1 and /fapi/v2 that touches account state.
+func (b *BinanceAdapter) signedQuery(params url.Values) url.Values {
+	params.Set("timestamp", strconv.FormatInt(time.Now().UnixMilli(), 10))
+	params.Set("recvWindow", strconv.Itoa(recvWindowMs))
+	params.Set("signature", b.sign(params))
+	return params
+}
+
+// doSignedRequest builds and executes a signed request against path,
+// returning the response body. The caller is responsible for decoding it
+// and must not forget the body is already fully read into memory.
+func (b *BinanceAdapter) doSignedRequest(ctx context.Context, method, path string, params url.Values) ([]byte, error) {
+	params = b.signedQuery(params)
+
+	reqURL := fmt.Sprintf("%s%s?%s", b.baseURL, path, params.Encode())
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("binance signed request: failed to build request: %w", err)
+	}
+	req.Header.Set("X-MBX-APIKEY", b.apiKey)
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("binance signed request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("binance signed request to %s: unexpected status %d: %s", path, resp.StatusCode, body)
+	}
+	return body, nil
+}
+
+// GetAccountBalance fetches the futures account's current balances via
+// /fapi/v1/account.
+func (b *BinanceAdapter) GetAccountBalance(ctx context.Context) ([]models.Balance, error) {
+	ctx = httpx.WithWeight(ctx, weightAccountInfo)
+	body, err := b.doSignedRequest(ctx, http.MethodGet, "/fapi/v1/account", url.Values{})
+	if err != nil {
+		return nil, fmt.Errorf("binance account balance: %w", err)
+	}
+
+	var raw struct {
+		Assets []struct {
+			Asset            string `json:"asset"`
+			AvailableBalance string `json:"availableBalance"`
+			InitialMargin    string `json:"initialMargin"`
+		} `json:"assets"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("binance account balance: failed to parse response: %w", err)
+	}
+
+	balances := make([]models.Balance, 0, len(raw.Assets))
+	for _, a := range raw.Assets {
+		free, _ := strconv.ParseFloat(a.AvailableBalance, 64)
+		locked, _ := strconv.ParseFloat(a.InitialMargin, 64)
+		balances = append(balances, models.Balance{
+			Exchange: "binance",
+			Asset:    a.Asset,
+			Free:     free,
+			Locked:   locked,
+		})
+	}
+	return balances, nil
+}
+
+// GetOpenPositions fetches the account's open positions for pair via
+// /fapi/v2/positionRisk.
+func (b *BinanceAdapter) GetOpenPositions(ctx context.Context, pair symbol.Pair) ([]models.Position, error) {
+	ctx = httpx.WithWeight(ctx, weightPositionRisk)
+	params := url.Values{"symbol": {b.codec.Encode(pair)}}
+
+	body, err := b.doSignedRequest(ctx, http.MethodGet, "/fapi/v2/positionRisk", params)
+	if err != nil {
+		return nil, fmt.Errorf("binance open positions: %w", err)
+	}
+
+	var raw []struct {
+		PositionAmt      string `json:"positionAmt"`
+		EntryPrice       string `json:"entryPrice"`
+		UnRealizedProfit string `json:"unRealizedProfit"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("binance open positions: failed to parse response: %w", err)
+	}
+
+	positions := make([]models.Position, 0, len(raw))
+	for _, p := range raw {
+		size, _ := strconv.ParseFloat(p.PositionAmt, 64)
+		if size == 0 {
+			continue // Binance reports one row per symbol regardless of whether a position is open
+		}
+
+		side := models.PositionSideLong
+		if size < 0 {
+			side = models.PositionSideShort
+			size = -size
+		}
+
+		entry, _ := strconv.ParseFloat(p.EntryPrice, 64)
+		pnl, _ := strconv.ParseFloat(p.UnRealizedProfit, 64)
+
+		positions = append(positions, models.Position{
+			Exchange:      "binance",
+			Pair:          pair,
+			Side:          side,
+			Size:          size,
+			EntryPrice:    entry,
+			UnrealizedPnL: pnl,
+		})
+	}
+	return positions, nil
+}
+
+// PlaceOrder submits req via /fapi/v1/order and returns Binance's
+// acknowledgement.
+func (b *BinanceAdapter) PlaceOrder(ctx context.Context, req models.OrderRequest) (*models.OrderAck, error) {
+	ctx = httpx.WithWeight(ctx, weightPlaceOrder)
+	params := url.Values{
+		"symbol":   {b.codec.Encode(req.Pair)},
+		"side":     {string(req.Side)},
+		"type":     {string(req.Type)},
+		"quantity": {strconv.FormatFloat(req.Quantity, 'f', -1, 64)},
+	}
+	if req.Type == models.OrderTypeLimit {
+		params.Set("price", strconv.FormatFloat(req.Price, 'f', -1, 64))
+		params.Set("timeInForce", "GTC")
+	}
+
+	body, err := b.doSignedRequest(ctx, http.MethodPost, "/fapi/v1/order", params)
+	if err != nil {
+		return nil, fmt.Errorf("binance place order: %w", err)
+	}
+
+	var raw struct {
+		OrderID     int64  `json:"orderId"`
+		Status      string `json:"status"`
+		ExecutedQty string `json:"executedQty"`
+		AvgPrice    string `json:"avgPrice"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("binance place order: failed to parse response: %w", err)
+	}
+
+	filledQty, _ := strconv.ParseFloat(raw.ExecutedQty, 64)
+	avgPrice, _ := strconv.ParseFloat(raw.AvgPrice, 64)
+
+	return &models.OrderAck{
+		Exchange:  "binance",
+		OrderID:   strconv.FormatInt(raw.OrderID, 10),
+		Status:    raw.Status,
+		FilledQty: filledQty,
+		AvgPrice:  avgPrice,
+		At:        time.Now(),
+	}, nil
+}
+
+// listenKeyKeepAliveInterval is how often a listen key must be refreshed to
+// keep Binance's user-data stream alive; Binance expires an unrefreshed key
+// after 60 minutes, so 30 minutes leaves comfortable margin.
+const listenKeyKeepAliveInterval = 30 * time.Minute
+
+// CreateListenKey opens a new user-data stream and returns its listen key,
+// used to subscribe to the wss://fstream.binance.com/ws/<listenKey> stream
+// for account events (balance updates, order fills).
+func (b *BinanceAdapter) CreateListenKey(ctx context.Context) (string, error) {
+	return b.listenKeyRequest(ctx, http.MethodPost, "")
+}
+
+// KeepAliveListenKey refreshes listenKey's 60-minute expiry. Callers should
+// do this at least every listenKeyKeepAliveInterval; see
+// StartListenKeyKeepAlive for a goroutine that handles this automatically.
+func (b *BinanceAdapter) KeepAliveListenKey(ctx context.Context, listenKey string) error {
+	_, err := b.listenKeyRequest(ctx, http.MethodPut, listenKey)
+	return err
+}
+
+// CloseListenKey closes the user-data stream associated with listenKey.
+func (b *BinanceAdapter) CloseListenKey(ctx context.Context, listenKey string) error {
+	_, err := b.listenKeyRequest(ctx, http.MethodDelete, listenKey)
+	return err
+}
+
+// listenKeyRequest issues method against /fapi/v1/listenKey, which (unlike
+// the account/position/order endpoints) is authenticated by the API-KEY
+// header alone and does not require a signature.
+func (b *BinanceAdapter) listenKeyRequest(ctx context.Context, method, listenKey string) (string, error) {
+	params := url.Values{}
+	if listenKey != "" {
+		params.Set("listenKey", listenKey)
+	}
+
+	reqURL := fmt.Sprintf("%s/fapi/v1/listenKey?%s", b.baseURL, params.Encode())
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("binance listen key: failed to build request: %w", err)
+	}
+	req.Header.Set("X-MBX-APIKEY", b.apiKey)
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("binance listen key request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("binance listen key: unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	var raw struct {
+		ListenKey string `json:"listenKey"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return "", fmt.Errorf("binance listen key: failed to parse response: %w", err)
+	}
+	return raw.ListenKey, nil
+}
+
+// StartListenKeyKeepAlive refreshes listenKey every listenKeyKeepAliveInterval
+// until ctx is cancelled, so a long-lived user-data stream subscription
+// doesn't silently expire. Keepalive failures are returned on the channel
+// rather than logged directly, leaving the caller free to decide whether a
+// failure warrants re-creating the listen key. The channel holds one unread
+// error; further failures are dropped until it is read.
+func (b *BinanceAdapter) StartListenKeyKeepAlive(ctx context.Context, listenKey string) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		ticker := time.NewTicker(listenKeyKeepAliveInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := b.KeepAliveListenKey(ctx, listenKey); err != nil {
+					select {
+					case errs <- err:
+					default:
+					}
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return errs
+}
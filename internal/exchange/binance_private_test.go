@@ -0,0 +1,92 @@
+package exchange
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/suwandre/arbiter/internal/exchange/conformance"
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+func TestBinanceAdapter_Sign(t *testing.T) {
+	adapter := &BinanceAdapter{apiSecret: "testsecret"}
+
+	params := url.Values{
+		"symbol":     {"BTCUSDT"},
+		"timestamp":  {"1700000000000"},
+		"recvWindow": {strconv.Itoa(recvWindowMs)},
+	}
+
+	got := adapter.sign(params)
+	const want = "c848f23c14e1e39ab9b87af2e2b433ebc78ab2393952b62660e5229c0c979fdf"
+	if got != want {
+		t.Errorf("sign(%v) = %q, want %q", params, got, want)
+	}
+}
+
+func TestBinanceAdapter_PlaceOrder(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/binance/order/ok.json", 0)
+	adapter := NewBinanceAdapterForTesting(conformance.RedirectingClient(t, server))
+	adapter.apiKey = "key"
+	adapter.apiSecret = "secret"
+
+	ack, err := adapter.PlaceOrder(context.Background(), models.OrderRequest{
+		Pair:     symbol.NewPair("BTC", "USDT"),
+		Side:     models.OrderSideBuy,
+		Type:     models.OrderTypeMarket,
+		Quantity: 0.015,
+	})
+	if err != nil {
+		t.Fatalf("PlaceOrder returned error: %v", err)
+	}
+
+	if ack.OrderID != "28457" {
+		t.Errorf("OrderID = %q, want %q", ack.OrderID, "28457")
+	}
+	if ack.Status != "FILLED" {
+		t.Errorf("Status = %q, want %q", ack.Status, "FILLED")
+	}
+	if ack.FilledQty != 0.015 || ack.AvgPrice != 65000.50 {
+		t.Errorf("FilledQty/AvgPrice = %v/%v, want 0.015/65000.50", ack.FilledQty, ack.AvgPrice)
+	}
+}
+
+func TestBinanceAdapter_PlaceOrder_ErrorStatus(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/binance/order/error.json", http.StatusBadRequest)
+	adapter := NewBinanceAdapterForTesting(conformance.RedirectingClient(t, server))
+	adapter.apiKey = "key"
+	adapter.apiSecret = "secret"
+
+	_, err := adapter.PlaceOrder(context.Background(), models.OrderRequest{
+		Pair:     symbol.NewPair("BTC", "USDT"),
+		Side:     models.OrderSideBuy,
+		Type:     models.OrderTypeMarket,
+		Quantity: 0.015,
+	})
+	if err == nil {
+		t.Fatal("PlaceOrder with a non-200 status: want error, got nil")
+	}
+}
+
+func TestBinanceAdapter_GetAccountBalance(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/binance/account/ok.json", 0)
+	adapter := NewBinanceAdapterForTesting(conformance.RedirectingClient(t, server))
+	adapter.apiKey = "key"
+	adapter.apiSecret = "secret"
+
+	balances, err := adapter.GetAccountBalance(context.Background())
+	if err != nil {
+		t.Fatalf("GetAccountBalance returned error: %v", err)
+	}
+
+	if len(balances) != 1 {
+		t.Fatalf("got %d balances, want 1", len(balances))
+	}
+	if balances[0].Asset != "USDT" || balances[0].Free != 1234.56 || balances[0].Locked != 100 {
+		t.Errorf("balance = %+v, want {Asset:USDT Free:1234.56 Locked:100}", balances[0])
+	}
+}
@@ -0,0 +1,403 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/suwandre/arbiter/internal/httpx"
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// pingInterval/pongWait bound the WebSocket keepalive: a ping is sent every
+// pingInterval, and the connection is considered dead if no frame (data or
+// pong) arrives within pongWait.
+const (
+	pingInterval = 3 * time.Minute
+	pongWait     = 10 * time.Minute
+)
+
+// StreamTicker subscribes to Binance's bookTicker stream for pair and emits
+// best bid/ask updates on the returned channel until ctx is cancelled.
+func (b *BinanceAdapter) StreamTicker(ctx context.Context, pair symbol.Pair) (<-chan *models.TickerUpdate, error) {
+	stream := strings.ToLower(b.codec.Encode(pair)) + "@bookTicker"
+	out := make(chan *models.TickerUpdate)
+
+	go func() {
+		defer close(out)
+
+		dialAndRead(ctx, b.streamBaseURL, stream, func(raw json.RawMessage) {
+			var msg struct {
+				Data struct {
+					BidPrice string `json:"b"`
+					AskPrice string `json:"a"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				log.Warn().Err(err).Str("exchange", "binance").Msg("failed to parse bookTicker message")
+				return
+			}
+
+			bid, _ := strconv.ParseFloat(msg.Data.BidPrice, 64)
+			ask, _ := strconv.ParseFloat(msg.Data.AskPrice, 64)
+
+			out <- &models.TickerUpdate{
+				Exchange: "binance",
+				Pair:     pair,
+				Bid:      bid,
+				Ask:      ask,
+				At:       time.Now(),
+			}
+		})
+	}()
+
+	return out, nil
+}
+
+// StreamFundingRate subscribes to Binance's markPrice stream for pair and
+// emits funding-rate updates on the returned channel until ctx is cancelled.
+func (b *BinanceAdapter) StreamFundingRate(ctx context.Context, pair symbol.Pair) (<-chan *models.FundingRate, error) {
+	stream := strings.ToLower(b.codec.Encode(pair)) + "@markPrice"
+	out := make(chan *models.FundingRate)
+
+	go func() {
+		defer close(out)
+
+		dialAndRead(ctx, b.streamBaseURL, stream, func(raw json.RawMessage) {
+			var msg struct {
+				Data struct {
+					FundingRate     string `json:"r"`
+					NextFundingTime int64  `json:"T"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				log.Warn().Err(err).Str("exchange", "binance").Msg("failed to parse markPrice message")
+				return
+			}
+
+			rate, err := strconv.ParseFloat(msg.Data.FundingRate, 64)
+			if err != nil {
+				log.Warn().Err(err).Str("exchange", "binance").Msg("failed to parse funding rate value")
+				return
+			}
+
+			out <- &models.FundingRate{
+				Exchange:    "binance",
+				Pair:        pair,
+				Rate:        rate,
+				NextFunding: time.UnixMilli(msg.Data.NextFundingTime),
+			}
+		})
+	}()
+
+	return out, nil
+}
+
+// StreamOrderBook subscribes to Binance's diff depth stream for pair and
+// maintains a local order book from it, applying the exchange-recommended
+// snapshot + diff pattern: a REST snapshot seeds the book, each diff's `pu`
+// is checked against the previous diff's `u` to detect a dropped update, and
+// a gap triggers a fresh REST resync rather than emitting a corrupt ladder.
+// The reassembled top-of-book ladder is emitted on the returned channel
+// until ctx is cancelled.
+func (b *BinanceAdapter) StreamOrderBook(ctx context.Context, pair symbol.Pair) (<-chan *models.OrderBookDepth, error) {
+	stream := strings.ToLower(b.codec.Encode(pair)) + "@depth@100ms"
+	out := make(chan *models.OrderBookDepth)
+
+	go func() {
+		defer close(out)
+
+		book := newBinanceLocalBook()
+
+		dialAndRead(ctx, b.streamBaseURL, stream, func(raw json.RawMessage) {
+			var msg struct {
+				Data struct {
+					FirstUpdateID int64      `json:"U"`
+					FinalUpdateID int64      `json:"u"`
+					PrevUpdateID  int64      `json:"pu"`
+					Bids          [][]string `json:"b"`
+					Asks          [][]string `json:"a"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				log.Warn().Err(err).Str("exchange", "binance").Msg("failed to parse depth message")
+				return
+			}
+
+			if !book.ready() {
+				snapshot, err := b.fetchDepthSnapshot(ctx, pair)
+				if err != nil {
+					log.Warn().Err(err).Str("exchange", "binance").Msg("failed to fetch depth snapshot, dropping event")
+					return
+				}
+				book.seed(snapshot)
+
+				if msg.Data.FinalUpdateID <= book.lastUpdateID {
+					return // stale relative to the snapshot we just took
+				}
+				if msg.Data.FirstUpdateID > book.lastUpdateID+1 {
+					// a gap already exists between the snapshot and the first
+					// buffered event; reset and wait for the next one.
+					book.reset()
+					return
+				}
+			} else if msg.Data.PrevUpdateID != book.lastUpdateID {
+				log.Warn().Str("exchange", "binance").Str("pair", pair.String()).Msg("depth update gap detected, resyncing")
+				book.reset()
+				return
+			}
+
+			book.apply(msg.Data.Bids, msg.Data.Asks, msg.Data.FinalUpdateID)
+
+			out <- &models.OrderBookDepth{
+				Exchange: "binance",
+				Pair:     pair,
+				Bids:     book.topBids(depthStreamLevels),
+				Asks:     book.topAsks(depthStreamLevels),
+			}
+		})
+	}()
+
+	return out, nil
+}
+
+// depthStreamLevels bounds how many rungs of the reassembled local book are
+// emitted per update, matching the REST depth endpoints' limit.
+const depthStreamLevels = 5
+
+// binanceLocalBook is the client-side order book reassembled from Binance's
+// diff depth stream, keyed by price for O(1) upserts/deletes.
+type binanceLocalBook struct {
+	lastUpdateID int64
+	bids         map[float64]float64
+	asks         map[float64]float64
+}
+
+func newBinanceLocalBook() *binanceLocalBook {
+	return &binanceLocalBook{bids: make(map[float64]float64), asks: make(map[float64]float64)}
+}
+
+// ready reports whether the book has been seeded from a REST snapshot since
+// the last reset.
+func (book *binanceLocalBook) ready() bool {
+	return book.lastUpdateID != 0
+}
+
+// reset discards the local book, forcing the next update to trigger a fresh
+// REST resync.
+func (book *binanceLocalBook) reset() {
+	book.lastUpdateID = 0
+	book.bids = make(map[float64]float64)
+	book.asks = make(map[float64]float64)
+}
+
+func (book *binanceLocalBook) seed(snapshot *binanceDepthSnapshot) {
+	book.bids = make(map[float64]float64, len(snapshot.Bids))
+	book.asks = make(map[float64]float64, len(snapshot.Asks))
+	applyLevelsToMap(snapshot.Bids, book.bids)
+	applyLevelsToMap(snapshot.Asks, book.asks)
+	book.lastUpdateID = snapshot.LastUpdateID
+}
+
+func (book *binanceLocalBook) apply(bids, asks [][]string, finalUpdateID int64) {
+	applyLevelsToMap(bids, book.bids)
+	applyLevelsToMap(asks, book.asks)
+	book.lastUpdateID = finalUpdateID
+}
+
+// applyLevelsToMap upserts [price, quantity] string pairs into levels,
+// deleting the price when quantity is zero (Binance's convention for
+// "this level is gone" in a diff update).
+func applyLevelsToMap(raw [][]string, levels map[float64]float64) {
+	for _, level := range raw {
+		price, _ := strconv.ParseFloat(level[0], 64)
+		qty, _ := strconv.ParseFloat(level[1], 64)
+		if qty == 0 {
+			delete(levels, price)
+			continue
+		}
+		levels[price] = qty
+	}
+}
+
+func (book *binanceLocalBook) topBids(n int) []models.PriceLevel {
+	return topLevels(book.bids, n, true)
+}
+
+func (book *binanceLocalBook) topAsks(n int) []models.PriceLevel {
+	return topLevels(book.asks, n, false)
+}
+
+// topLevels sorts levels by price (descending for bids, ascending for asks)
+// and returns the best n as canonical price levels.
+func topLevels(levels map[float64]float64, n int, descending bool) []models.PriceLevel {
+	prices := make([]float64, 0, len(levels))
+	for price := range levels {
+		prices = append(prices, price)
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		if descending {
+			return prices[i] > prices[j]
+		}
+		return prices[i] < prices[j]
+	})
+
+	if len(prices) > n {
+		prices = prices[:n]
+	}
+
+	out := make([]models.PriceLevel, len(prices))
+	for i, price := range prices {
+		out[i] = models.PriceLevel{Price: price, Qty: levels[price]}
+	}
+	return out
+}
+
+// binanceDepthSnapshot is a REST order book snapshot along with the
+// lastUpdateId needed to align it against the diff depth stream.
+type binanceDepthSnapshot struct {
+	LastUpdateID int64
+	Bids         [][]string
+	Asks         [][]string
+}
+
+// fetchDepthSnapshot fetches a fresh REST order book snapshot for pair,
+// used to seed or resync the local book maintained by StreamOrderBook.
+func (b *BinanceAdapter) fetchDepthSnapshot(ctx context.Context, pair symbol.Pair) (*binanceDepthSnapshot, error) {
+	ctx = httpx.WithWeight(ctx, weightDepthSnapshot)
+	url := fmt.Sprintf("%s/fapi/v1/depth?symbol=%s&limit=%d", b.baseURL, b.codec.Encode(pair), 100)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("binance depth snapshot: failed to build request: %w", err)
+	}
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("binance depth snapshot request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("binance depth snapshot: unexpected status %d", resp.StatusCode)
+	}
+
+	var raw struct {
+		LastUpdateID int64      `json:"lastUpdateId"`
+		Bids         [][]string `json:"bids"`
+		Asks         [][]string `json:"asks"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("binance depth snapshot: failed to parse response: %w", err)
+	}
+
+	return &binanceDepthSnapshot{LastUpdateID: raw.LastUpdateID, Bids: raw.Bids, Asks: raw.Asks}, nil
+}
+
+// dialAndRead maintains a Binance combined-stream connection for stream on
+// streamBaseURL (mainnet or testnet), reconnecting with exponential backoff
+// on failure, and invokes handle with each frame's raw payload until ctx is
+// cancelled.
+func dialAndRead(ctx context.Context, streamBaseURL, stream string, handle func(json.RawMessage)) {
+	backoff := time.Second
+	const maxBackoff = 30 * time.Second
+
+	for ctx.Err() == nil {
+		url := fmt.Sprintf("%s?streams=%s", streamBaseURL, stream)
+		conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+		if err != nil {
+			log.Warn().Err(err).Str("exchange", "binance").Str("stream", stream).Msg("websocket dial failed, retrying")
+			if !sleepOrDone(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff, maxBackoff)
+			continue
+		}
+
+		backoff = time.Second
+		readUntilError(ctx, conn, handle)
+	}
+}
+
+// readUntilError reads frames off conn until it errors or ctx is cancelled,
+// invoking handle with each frame's raw payload. It keeps the connection
+// alive with a periodic client-initiated ping and a read deadline extended
+// on every pong, data frame, or server-initiated ping.
+func readUntilError(ctx context.Context, conn *websocket.Conn, handle func(json.RawMessage)) {
+	defer conn.Close()
+
+	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPingHandler(func(appData string) error {
+		conn.SetReadDeadline(time.Now().Add(pongWait))
+		return conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(10*time.Second))
+	})
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(10*time.Second)); err != nil {
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Warn().Err(err).Msg("websocket read failed, reconnecting")
+			}
+			return
+		}
+		conn.SetReadDeadline(time.Now().Add(pongWait))
+		handle(raw)
+	}
+}
+
+func sleepOrDone(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func nextBackoff(cur, max time.Duration) time.Duration {
+	next := cur * 2
+	if next > max {
+		return max
+	}
+	return next
+}
@@ -0,0 +1,77 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	"github.com/suwandre/arbiter/internal/exchange/conformance"
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+func TestBinanceAdapter_GetFundingRate(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/binance/premiumIndex/ok.json", 0)
+	adapter := NewBinanceAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	funding, err := adapter.GetFundingRate(context.Background(), symbol.NewPair("BTC", "USDT"))
+	if err != nil {
+		t.Fatalf("GetFundingRate returned error: %v", err)
+	}
+
+	if funding.Rate != 0.0001 {
+		t.Errorf("Rate = %v, want 0.0001", funding.Rate)
+	}
+	if funding.Exchange != "binance" {
+		t.Errorf("Exchange = %q, want binance", funding.Exchange)
+	}
+}
+
+func TestBinanceAdapter_GetSpread(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/binance/bookTicker/ok.json", 0)
+	adapter := NewBinanceAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	spread, err := adapter.GetSpread(context.Background(), symbol.NewPair("BTC", "USDT"))
+	if err != nil {
+		t.Fatalf("GetSpread returned error: %v", err)
+	}
+
+	if spread.Bid != 64999.50 || spread.Ask != 65000.50 {
+		t.Errorf("Bid/Ask = %v/%v, want 64999.50/65000.50", spread.Bid, spread.Ask)
+	}
+}
+
+func TestBinanceAdapter_GetOrderBookDepth(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/binance/depth/ok.json", 0)
+	adapter := NewBinanceAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	depth, err := adapter.GetOrderBookDepth(context.Background(), symbol.NewPair("BTC", "USDT"))
+	if err != nil {
+		t.Fatalf("GetOrderBookDepth returned error: %v", err)
+	}
+
+	const wantBidDepth = 194997.50
+	const wantAskDepth = 260003.25
+	bidDepth := models.NotionalDepth(depth.Bids)
+	askDepth := models.NotionalDepth(depth.Asks)
+	if bidDepth != wantBidDepth || askDepth != wantAskDepth {
+		t.Errorf("bid/ask depth = %v/%v, want %v/%v", bidDepth, askDepth, wantBidDepth, wantAskDepth)
+	}
+}
+
+func TestBinanceAdapter_GetSpread_MalformedPrice(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/binance/bookTicker/malformed.json", 0)
+	adapter := NewBinanceAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	if _, err := adapter.GetSpread(context.Background(), symbol.NewPair("BTC", "USDT")); err == nil {
+		t.Fatal("GetSpread with a malformed bid price: want error, got nil")
+	}
+}
+
+func TestBinanceAdapter_GetOrderBookDepth_MalformedLevel(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/binance/depth/malformed.json", 0)
+	adapter := NewBinanceAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	if _, err := adapter.GetOrderBookDepth(context.Background(), symbol.NewPair("BTC", "USDT")); err == nil {
+		t.Fatal("GetOrderBookDepth with a malformed price level: want error, got nil")
+	}
+}
@@ -10,19 +10,82 @@ import (
 	"time"
 
 	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// Default and testnet REST/stream base URLs, mirroring BinanceConfig's
+// UseTestnet toggle.
+const (
+	bybitBaseURL           = "https://api.bybit.com"
+	bybitStreamBaseURLProd = "wss://stream.bybit.com/v5/public/linear"
+	bybitTestnetBaseURL    = "https://api-testnet.bybit.com"
+	bybitTestnetStreamURL  = "wss://stream-testnet.bybit.com/v5/public/linear"
 )
 
 type BybitAdapter struct {
-	apiKey     string
-	httpClient *http.Client
+	apiKey        string
+	baseURL       string
+	streamBaseURL string
+	httpClient    *http.Client
+	codec         symbol.SymbolCodec
+}
+
+// BybitConfig configures a BybitAdapter. The zero value is meaningful:
+// every field defaults sensibly, so `NewBybitAdapter(BybitConfig{APIKey:
+// key})` is enough for the common mainnet case.
+type BybitConfig struct {
+	APIKey string
+
+	// BaseURL overrides the REST base URL. Defaults to the mainnet API, or
+	// UseTestnet's URL if BaseURL is left empty and UseTestnet is set.
+	BaseURL string
+
+	// UseTestnet points BaseURL at Bybit's testnet instead of mainnet,
+	// ignored if BaseURL is set explicitly.
+	UseTestnet bool
+
+	// HTTPClient overrides the adapter's HTTP client. Defaults to a plain
+	// 10-second-timeout client if nil.
+	HTTPClient *http.Client
+}
+
+// NewBybitAdapter builds a BybitAdapter from cfg, defaulting BaseURL and
+// HTTPClient when left zero-valued.
+func NewBybitAdapter(cfg BybitConfig) *BybitAdapter {
+	baseURL := cfg.BaseURL
+	streamBaseURL := bybitStreamBaseURLProd
+	if baseURL == "" {
+		if cfg.UseTestnet {
+			baseURL = bybitTestnetBaseURL
+			streamBaseURL = bybitTestnetStreamURL
+		} else {
+			baseURL = bybitBaseURL
+		}
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &BybitAdapter{
+		apiKey:        cfg.APIKey,
+		baseURL:       baseURL,
+		streamBaseURL: streamBaseURL,
+		httpClient:    httpClient,
+		codec:         symbol.ConcatCodec{},
+	}
 }
 
-func NewBybitAdapter(apiKey string) *BybitAdapter {
+// NewBybitAdapterForTesting builds a BybitAdapter backed by client instead
+// of the default HTTP client, so the conformance suite can point it at an
+// httptest.Server.
+func NewBybitAdapterForTesting(client *http.Client) *BybitAdapter {
 	return &BybitAdapter{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		baseURL:       bybitBaseURL,
+		streamBaseURL: bybitStreamBaseURLProd,
+		httpClient:    client,
+		codec:         symbol.ConcatCodec{},
 	}
 }
 
@@ -30,10 +93,10 @@ func (b *BybitAdapter) Name() string {
 	return "bybit"
 }
 
-func (b *BybitAdapter) GetFundingRate(ctx context.Context, pair string) (*models.FundingRate, error) {
+func (b *BybitAdapter) GetFundingRate(ctx context.Context, pair symbol.Pair) (*models.FundingRate, error) {
 	url := fmt.Sprintf(
-		"https://api.bybit.com/v5/market/funding/history?category=linear&symbol=%s&limit=1",
-		pair,
+		"%s/v5/market/funding/history?category=linear&symbol=%s&limit=1",
+		b.baseURL, b.codec.Encode(pair),
 	)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -97,10 +160,10 @@ func (b *BybitAdapter) GetFundingRate(ctx context.Context, pair string) (*models
 	}, nil
 }
 
-func (b *BybitAdapter) GetSpread(ctx context.Context, pair string) (*models.Spread, error) {
+func (b *BybitAdapter) GetSpread(ctx context.Context, pair symbol.Pair) (*models.Spread, error) {
 	url := fmt.Sprintf(
-		"https://api.bybit.com/v5/market/tickers?category=linear&symbol=%s",
-		pair,
+		"%s/v5/market/tickers?category=linear&symbol=%s",
+		b.baseURL, b.codec.Encode(pair),
 	)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -143,8 +206,14 @@ func (b *BybitAdapter) GetSpread(ctx context.Context, pair string) (*models.Spre
 	}
 
 	entry := raw.Result.List[0]
-	bid, _ := strconv.ParseFloat(entry.Bid1Price, 64)
-	ask, _ := strconv.ParseFloat(entry.Ask1Price, 64)
+	bid, err := strconv.ParseFloat(entry.Bid1Price, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bybit spread: failed to parse bid price %q: %w", entry.Bid1Price, err)
+	}
+	ask, err := strconv.ParseFloat(entry.Ask1Price, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bybit spread: failed to parse ask price %q: %w", entry.Ask1Price, err)
+	}
 
 	return &models.Spread{
 		Exchange: "bybit",
@@ -155,10 +224,10 @@ func (b *BybitAdapter) GetSpread(ctx context.Context, pair string) (*models.Spre
 	}, nil
 }
 
-func (b *BybitAdapter) GetOrderBookDepth(ctx context.Context, pair string) (*models.OrderBookDepth, error) {
+func (b *BybitAdapter) GetOrderBookDepth(ctx context.Context, pair symbol.Pair) (*models.OrderBookDepth, error) {
 	url := fmt.Sprintf(
-		"https://api.bybit.com/v5/market/orderbook?category=linear&symbol=%s&limit=5",
-		pair,
+		"%s/v5/market/orderbook?category=linear&symbol=%s&limit=5",
+		b.baseURL, b.codec.Encode(pair),
 	)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -194,10 +263,19 @@ func (b *BybitAdapter) GetOrderBookDepth(ctx context.Context, pair string) (*mod
 		return nil, fmt.Errorf("bybit API error %d: %s", raw.RetCode, raw.RetMsg)
 	}
 
+	bids, err := parseLevels(raw.Result.Bids)
+	if err != nil {
+		return nil, fmt.Errorf("bybit depth: %w", err)
+	}
+	asks, err := parseLevels(raw.Result.Asks)
+	if err != nil {
+		return nil, fmt.Errorf("bybit depth: %w", err)
+	}
+
 	return &models.OrderBookDepth{
 		Exchange: "bybit",
 		Pair:     pair,
-		BidDepth: sumDepth(raw.Result.Bids),
-		AskDepth: sumDepth(raw.Result.Asks),
+		Bids:     bids,
+		Asks:     asks,
 	}, nil
 }
@@ -0,0 +1,173 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// StreamTicker subscribes to Bybit's tickers topic for pair and emits best
+// bid/ask updates on the returned channel until ctx is cancelled.
+func (b *BybitAdapter) StreamTicker(ctx context.Context, pair symbol.Pair) (<-chan *models.TickerUpdate, error) {
+	out := make(chan *models.TickerUpdate)
+
+	go func() {
+		defer close(out)
+
+		bybitDialAndRead(ctx, b.streamBaseURL, "tickers."+b.codec.Encode(pair), func(raw json.RawMessage) {
+			var msg struct {
+				Topic string `json:"topic"`
+				Data  struct {
+					Bid1Price string `json:"bid1Price"`
+					Ask1Price string `json:"ask1Price"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				log.Warn().Err(err).Str("exchange", "bybit").Msg("failed to parse ticker message")
+				return
+			}
+			if msg.Data.Bid1Price == "" && msg.Data.Ask1Price == "" {
+				return
+			}
+
+			bid, _ := strconv.ParseFloat(msg.Data.Bid1Price, 64)
+			ask, _ := strconv.ParseFloat(msg.Data.Ask1Price, 64)
+
+			out <- &models.TickerUpdate{
+				Exchange: "bybit",
+				Pair:     pair,
+				Bid:      bid,
+				Ask:      ask,
+				At:       time.Now(),
+			}
+		})
+	}()
+
+	return out, nil
+}
+
+// StreamOrderBook subscribes to Bybit's orderbook.50 topic for pair and
+// maintains a local order book from it: Bybit sends one "snapshot" message
+// seeding the full ladder, followed by "delta" messages carrying only the
+// levels that changed since (qty "0" meaning the level is gone). The
+// reassembled top-of-book ladder is emitted on the returned channel until
+// ctx is cancelled.
+func (b *BybitAdapter) StreamOrderBook(ctx context.Context, pair symbol.Pair) (<-chan *models.OrderBookDepth, error) {
+	out := make(chan *models.OrderBookDepth)
+
+	go func() {
+		defer close(out)
+
+		book := newBybitLocalBook()
+
+		bybitDialAndRead(ctx, b.streamBaseURL, "orderbook.50."+b.codec.Encode(pair), func(raw json.RawMessage) {
+			var msg struct {
+				Type  string `json:"type"`
+				Topic string `json:"topic"`
+				Data  struct {
+					Bids [][]string `json:"b"`
+					Asks [][]string `json:"a"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				log.Warn().Err(err).Str("exchange", "bybit").Msg("failed to parse orderbook message")
+				return
+			}
+
+			switch msg.Type {
+			case "snapshot":
+				book.seed(msg.Data.Bids, msg.Data.Asks)
+			case "delta":
+				book.apply(msg.Data.Bids, msg.Data.Asks)
+			default:
+				return // subscription ack or other non-book frame
+			}
+
+			out <- &models.OrderBookDepth{
+				Exchange: "bybit",
+				Pair:     pair,
+				Bids:     book.topBids(depthStreamLevels),
+				Asks:     book.topAsks(depthStreamLevels),
+			}
+		})
+	}()
+
+	return out, nil
+}
+
+// bybitLocalBook is the client-side order book reassembled from Bybit's
+// orderbook.50 snapshot + delta messages, keyed by price for O(1)
+// upserts/deletes (mirrors binanceLocalBook in binance_stream.go).
+type bybitLocalBook struct {
+	bids map[float64]float64
+	asks map[float64]float64
+}
+
+func newBybitLocalBook() *bybitLocalBook {
+	return &bybitLocalBook{bids: make(map[float64]float64), asks: make(map[float64]float64)}
+}
+
+// seed replaces the local book wholesale from a "snapshot" message's
+// levels.
+func (book *bybitLocalBook) seed(bids, asks [][]string) {
+	book.bids = make(map[float64]float64, len(bids))
+	book.asks = make(map[float64]float64, len(asks))
+	applyLevelsToMap(bids, book.bids)
+	applyLevelsToMap(asks, book.asks)
+}
+
+// apply merges a "delta" message's changed levels into the local book,
+// upserting non-zero quantities and deleting zero-quantity ones.
+func (book *bybitLocalBook) apply(bids, asks [][]string) {
+	applyLevelsToMap(bids, book.bids)
+	applyLevelsToMap(asks, book.asks)
+}
+
+func (book *bybitLocalBook) topBids(n int) []models.PriceLevel {
+	return topLevels(book.bids, n, true)
+}
+
+func (book *bybitLocalBook) topAsks(n int) []models.PriceLevel {
+	return topLevels(book.asks, n, false)
+}
+
+// bybitDialAndRead maintains a subscription to topic on streamURL (Bybit's
+// public linear WS endpoint, mainnet or testnet), reconnecting with
+// exponential backoff on failure, and invokes handle with each frame's raw
+// payload until ctx is cancelled.
+func bybitDialAndRead(ctx context.Context, streamURL, topic string, handle func(json.RawMessage)) {
+	backoff := time.Second
+	const maxBackoff = 30 * time.Second
+
+	for ctx.Err() == nil {
+		conn, _, err := websocket.DefaultDialer.DialContext(ctx, streamURL, nil)
+		if err != nil {
+			log.Warn().Err(err).Str("exchange", "bybit").Str("topic", topic).Msg("websocket dial failed, retrying")
+			if !sleepOrDone(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff, maxBackoff)
+			continue
+		}
+
+		sub := map[string]any{"op": "subscribe", "args": []string{topic}}
+		if err := conn.WriteJSON(sub); err != nil {
+			log.Warn().Err(err).Str("exchange", "bybit").Msg("failed to send subscribe frame")
+			conn.Close()
+			if !sleepOrDone(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff, maxBackoff)
+			continue
+		}
+
+		backoff = time.Second
+		readUntilError(ctx, conn, handle)
+	}
+}
@@ -0,0 +1,46 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/suwandre/arbiter/internal/models"
+)
+
+func TestBybitLocalBook_SeedThenDelta(t *testing.T) {
+	book := newBybitLocalBook()
+
+	book.seed(
+		[][]string{{"64990", "1.0"}, {"64980", "2.0"}, {"64970", "3.0"}},
+		[][]string{{"65000", "1.0"}, {"65010", "2.0"}, {"65020", "3.0"}},
+	)
+
+	if got := book.topBids(5); len(got) != 3 || got[0] != (models.PriceLevel{Price: 64990, Qty: 1.0}) {
+		t.Fatalf("topBids after seed = %+v, want best bid 64990/1.0", got)
+	}
+	if got := book.topAsks(5); len(got) != 3 || got[0] != (models.PriceLevel{Price: 65000, Qty: 1.0}) {
+		t.Fatalf("topAsks after seed = %+v, want best ask 65000/1.0", got)
+	}
+
+	// Delta: update one bid level's quantity, remove another, and add a
+	// brand-new best ask that wasn't in the snapshot.
+	book.apply(
+		[][]string{{"64990", "1.5"}, {"64980", "0"}},
+		[][]string{{"64995", "0.5"}},
+	)
+
+	bids := book.topBids(5)
+	if len(bids) != 2 {
+		t.Fatalf("topBids after delta = %+v, want 2 levels (64980 removed)", bids)
+	}
+	if bids[0] != (models.PriceLevel{Price: 64990, Qty: 1.5}) {
+		t.Errorf("best bid after delta = %+v, want 64990/1.5 (updated quantity)", bids[0])
+	}
+
+	asks := book.topAsks(5)
+	if len(asks) != 4 {
+		t.Fatalf("topAsks after delta = %+v, want 4 levels (64995 added)", asks)
+	}
+	if asks[0] != (models.PriceLevel{Price: 64995, Qty: 0.5}) {
+		t.Errorf("best ask after delta = %+v, want 64995/0.5 (new level ahead of snapshot)", asks[0])
+	}
+}
@@ -0,0 +1,92 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	"github.com/suwandre/arbiter/internal/exchange/conformance"
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+func TestBybitAdapter_GetFundingRate(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/bybit/funding/ok.json", 0)
+	adapter := NewBybitAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	funding, err := adapter.GetFundingRate(context.Background(), symbol.NewPair("BTC", "USDT"))
+	if err != nil {
+		t.Fatalf("GetFundingRate returned error: %v", err)
+	}
+
+	if funding.Rate != 0.0001 {
+		t.Errorf("Rate = %v, want 0.0001", funding.Rate)
+	}
+}
+
+func TestBybitAdapter_GetFundingRate_EmptyList(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/bybit/funding/empty_list.json", 0)
+	adapter := NewBybitAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	if _, err := adapter.GetFundingRate(context.Background(), symbol.NewPair("BTC", "USDT")); err == nil {
+		t.Fatal("GetFundingRate with an empty result list: want error, got nil")
+	}
+}
+
+func TestBybitAdapter_GetFundingRate_RetCodeError(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/bybit/funding/ret_code_error.json", 0)
+	adapter := NewBybitAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	if _, err := adapter.GetFundingRate(context.Background(), symbol.NewPair("BTC", "USDT")); err == nil {
+		t.Fatal("GetFundingRate with a non-zero retCode: want error, got nil")
+	}
+}
+
+func TestBybitAdapter_GetSpread(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/bybit/tickers/ok.json", 0)
+	adapter := NewBybitAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	spread, err := adapter.GetSpread(context.Background(), symbol.NewPair("BTC", "USDT"))
+	if err != nil {
+		t.Fatalf("GetSpread returned error: %v", err)
+	}
+
+	if spread.Bid != 64999.5 || spread.Ask != 65000.5 {
+		t.Errorf("Bid/Ask = %v/%v, want 64999.5/65000.5", spread.Bid, spread.Ask)
+	}
+}
+
+func TestBybitAdapter_GetOrderBookDepth(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/bybit/orderbook/ok.json", 0)
+	adapter := NewBybitAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	depth, err := adapter.GetOrderBookDepth(context.Background(), symbol.NewPair("BTC", "USDT"))
+	if err != nil {
+		t.Fatalf("GetOrderBookDepth returned error: %v", err)
+	}
+
+	const wantBidDepth = 194997.5
+	const wantAskDepth = 260003.25
+	bidDepth := models.NotionalDepth(depth.Bids)
+	askDepth := models.NotionalDepth(depth.Asks)
+	if bidDepth != wantBidDepth || askDepth != wantAskDepth {
+		t.Errorf("bid/ask depth = %v/%v, want %v/%v", bidDepth, askDepth, wantBidDepth, wantAskDepth)
+	}
+}
+
+func TestBybitAdapter_GetSpread_MalformedPrice(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/bybit/tickers/malformed.json", 0)
+	adapter := NewBybitAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	if _, err := adapter.GetSpread(context.Background(), symbol.NewPair("BTC", "USDT")); err == nil {
+		t.Fatal("GetSpread with a malformed bid price: want error, got nil")
+	}
+}
+
+func TestBybitAdapter_GetOrderBookDepth_MalformedLevel(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/bybit/orderbook/malformed.json", 0)
+	adapter := NewBybitAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	if _, err := adapter.GetOrderBookDepth(context.Background(), symbol.NewPair("BTC", "USDT")); err == nil {
+		t.Fatal("GetOrderBookDepth with a malformed price level: want error, got nil")
+	}
+}
@@ -0,0 +1,79 @@
+// Package conformance provides a small fixture-driven test harness shared
+// by every exchange adapter's test suite, so response-parsing drift
+// (envelope changes, renamed fields, non-zero error codes) is caught the
+// same way for Binance, Bybit, and MEXC instead of adapter-by-adapter.
+package conformance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+)
+
+// NewFixtureServer starts an httptest.Server that responds to every request
+// with the contents of fixturePath and statusCode (defaulting to 200 OK).
+// The server is closed automatically when the test completes.
+func NewFixtureServer(t *testing.T, fixturePath string, statusCode int) *httptest.Server {
+	t.Helper()
+
+	body, err := os.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatalf("conformance: failed to read fixture %s: %v", fixturePath, err)
+	}
+
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// RedirectingClient returns an *http.Client that rewrites every outgoing
+// request's scheme and host to server's, regardless of what base URL the
+// adapter under test built the request against. This lets adapters keep
+// their hardcoded production URLs while still being exercised against an
+// httptest.Server.
+func RedirectingClient(t *testing.T, server *httptest.Server) *http.Client {
+	t.Helper()
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("conformance: invalid httptest server URL %q: %v", server.URL, err)
+	}
+
+	return &http.Client{
+		Transport: &redirectTransport{target: target, base: http.DefaultTransport},
+	}
+}
+
+type redirectTransport struct {
+	target *url.URL
+	base   http.RoundTripper
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return t.base.RoundTrip(req)
+}
+
+// SkipUnlessLive skips the calling test unless live-endpoint smoke tests
+// have been explicitly opted into via RUN_LIVE=1. These tests hit real
+// exchange APIs and consume real rate-limit budget, so they're off by
+// default — including in CI — and must be opted into deliberately.
+func SkipUnlessLive(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("RUN_LIVE") != "1" {
+		t.Skip("skipping live-endpoint smoke test: set RUN_LIVE=1 to run")
+	}
+}
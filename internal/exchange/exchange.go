@@ -4,11 +4,74 @@ import (
 	"context"
 
 	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
 )
 
 type Exchange interface {
-	GetFundingRate(ctx context.Context, pair string) (*models.FundingRate, error)
-	GetSpread(ctx context.Context, pair string) (*models.Spread, error)
-	GetOrderBookDepth(ctx context.Context, pair string) (*models.OrderBookDepth, error)
+	GetFundingRate(ctx context.Context, pair symbol.Pair) (*models.FundingRate, error)
+	GetSpread(ctx context.Context, pair symbol.Pair) (*models.Spread, error)
+	GetOrderBookDepth(ctx context.Context, pair symbol.Pair) (*models.OrderBookDepth, error)
 	Name() string
 }
+
+// StreamingExchange is implemented by adapters that can push live updates
+// over a WebSocket instead of being polled. Scorer/Scheduler type-assert
+// Exchange values against this interface and prefer streaming when it's
+// available, falling back to the REST methods on Exchange for cold-start
+// and reconnect gaps.
+type StreamingExchange interface {
+	Exchange
+
+	// StreamTicker subscribes to best bid/ask updates for pair. The returned
+	// channel is closed when ctx is cancelled or the subscription can no
+	// longer be maintained.
+	StreamTicker(ctx context.Context, pair symbol.Pair) (<-chan *models.TickerUpdate, error)
+
+	// StreamOrderBook subscribes to order book depth updates for pair.
+	StreamOrderBook(ctx context.Context, pair symbol.Pair) (<-chan *models.OrderBookDepth, error)
+}
+
+// FundingStreamingExchange is implemented by adapters that can push live
+// funding-rate updates over a WebSocket, e.g. Binance's mark-price stream.
+// It's a separate optional capability from StreamingExchange since not
+// every exchange exposes a mark-price stream alongside its ticker/depth
+// streams.
+type FundingStreamingExchange interface {
+	Exchange
+
+	// StreamFundingRate subscribes to funding-rate updates for pair. The
+	// returned channel is closed when ctx is cancelled or the subscription
+	// can no longer be maintained.
+	StreamFundingRate(ctx context.Context, pair symbol.Pair) (<-chan *models.FundingRate, error)
+}
+
+// PrivateExchange is implemented by adapters carrying credentials that can
+// sign requests against authenticated endpoints (account balances,
+// positions, order placement). It's a separate optional capability from
+// Exchange's public market-data methods, matching StreamingExchange's
+// pattern, since an adapter configured without an API secret can't satisfy
+// it.
+type PrivateExchange interface {
+	Exchange
+
+	// GetAccountBalance fetches the account's current balances.
+	GetAccountBalance(ctx context.Context) ([]models.Balance, error)
+
+	// GetOpenPositions fetches the account's open positions for pair.
+	GetOpenPositions(ctx context.Context, pair symbol.Pair) ([]models.Position, error)
+
+	// PlaceOrder submits req and returns the exchange's acknowledgement.
+	PlaceOrder(ctx context.Context, req models.OrderRequest) (*models.OrderAck, error)
+}
+
+// KlineExchange is implemented by adapters that can fetch historical OHLC
+// candles, used by the scorer's rolling-volatility signal. It's a separate
+// optional capability from Exchange since not every adapter exposes a
+// kline endpoint yet.
+type KlineExchange interface {
+	Exchange
+
+	// GetKlines fetches candles for pair at interval, narrowed by opts
+	// (start/end time, result limit).
+	GetKlines(ctx context.Context, pair symbol.Pair, interval models.KlineInterval, opts ...models.KlineOption) ([]models.Kline, error)
+}
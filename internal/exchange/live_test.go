@@ -0,0 +1,35 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	"github.com/suwandre/arbiter/internal/exchange/conformance"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// TestLive_GetSpread hits each real exchange's spread endpoint for BTC/USDT.
+// It's a smoke test, not a conformance check, so it only asserts the call
+// succeeds — skipped by default; set RUN_LIVE=1 to opt in.
+func TestLive_GetSpread(t *testing.T) {
+	conformance.SkipUnlessLive(t)
+
+	pair := symbol.NewPair("BTC", "USDT")
+	exchanges := []Exchange{
+		NewBinanceAdapter(BinanceConfig{}),
+		NewBybitAdapter(BybitConfig{}),
+		NewMexcAdapter(""),
+	}
+
+	for _, ex := range exchanges {
+		t.Run(ex.Name(), func(t *testing.T) {
+			spread, err := ex.GetSpread(context.Background(), pair)
+			if err != nil {
+				t.Fatalf("GetSpread failed: %v", err)
+			}
+			if spread.Bid <= 0 || spread.Ask <= 0 {
+				t.Errorf("got non-positive bid/ask: %+v", spread)
+			}
+		})
+	}
+}
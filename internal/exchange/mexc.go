@@ -6,15 +6,16 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
 )
 
 type MexcAdapter struct {
 	apiKey     string
 	httpClient *http.Client
+	codec      symbol.SymbolCodec
 }
 
 type mexcTicker struct {
@@ -30,14 +31,22 @@ func NewMexcAdapter(apiKey string) *MexcAdapter {
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		codec: symbol.UnderscoreCodec{},
 	}
 }
 
+// NewMexcAdapterForTesting builds a MexcAdapter backed by client instead of
+// the default HTTP client, so the conformance suite can point it at an
+// httptest.Server.
+func NewMexcAdapterForTesting(client *http.Client) *MexcAdapter {
+	return &MexcAdapter{httpClient: client, codec: symbol.UnderscoreCodec{}}
+}
+
 func (m *MexcAdapter) Name() string {
 	return "mexc"
 }
 
-func (m *MexcAdapter) GetFundingRate(ctx context.Context, pair string) (*models.FundingRate, error) {
+func (m *MexcAdapter) GetFundingRate(ctx context.Context, pair symbol.Pair) (*models.FundingRate, error) {
 	ticker, err := m.fetchTicker(ctx, pair)
 	if err != nil {
 		return nil, err
@@ -53,7 +62,7 @@ func (m *MexcAdapter) GetFundingRate(ctx context.Context, pair string) (*models.
 	}, nil
 }
 
-func (m *MexcAdapter) GetSpread(ctx context.Context, pair string) (*models.Spread, error) {
+func (m *MexcAdapter) GetSpread(ctx context.Context, pair symbol.Pair) (*models.Spread, error) {
 	ticker, err := m.fetchTicker(ctx, pair)
 	if err != nil {
 		return nil, err
@@ -68,10 +77,10 @@ func (m *MexcAdapter) GetSpread(ctx context.Context, pair string) (*models.Sprea
 	}, nil
 }
 
-func (m *MexcAdapter) GetOrderBookDepth(ctx context.Context, pair string) (*models.OrderBookDepth, error) {
+func (m *MexcAdapter) GetOrderBookDepth(ctx context.Context, pair symbol.Pair) (*models.OrderBookDepth, error) {
 	url := fmt.Sprintf(
 		"https://contract.mexc.com/api/v1/contract/depth/%s?limit=5",
-		toMexcSymbol(pair),
+		m.codec.Encode(pair),
 	)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -115,39 +124,32 @@ func (m *MexcAdapter) GetOrderBookDepth(ctx context.Context, pair string) (*mode
 	return &models.OrderBookDepth{
 		Exchange: "mexc",
 		Pair:     pair,
-		BidDepth: sumMexcDepth(raw.Data.Bids),
-		AskDepth: sumMexcDepth(raw.Data.Asks),
+		Bids:     mexcLevels(raw.Data.Bids),
+		Asks:     mexcLevels(raw.Data.Asks),
 	}, nil
 }
 
-func sumMexcDepth(levels [][]float64) float64 {
+// mexcLevels converts MEXC's [price, contractCount, orderCount] entries into
+// canonical price levels, translating contract count into base-asset
+// quantity via mexcContractSize.
+func mexcLevels(raw [][]float64) []models.PriceLevel {
 	// NOTE: taken from BTC's contract detail from https://api.mexc.com/api/v1/contract/detail
 	const mexcContractSize = 0.0001
 
-	total := 0.0
-	for _, level := range levels {
-		if len(level) >= 2 {
-			price := level[0]
-			contracts := level[1]
-			total += contracts * mexcContractSize * price // â†’ USDT notional
+	levels := make([]models.PriceLevel, 0, len(raw))
+	for _, level := range raw {
+		if len(level) < 2 {
+			continue
 		}
+		levels = append(levels, models.PriceLevel{Price: level[0], Qty: level[1] * mexcContractSize})
 	}
-	return total
-}
-
-// MEXC futures uses `TOKEN1_TOKEN2` (e.g. BTC_USDT) format,
-// while the rest of the app uses `TOKEN1TOKEN2` (e.g. BTCUSDT).
-func toMexcSymbol(pair string) string {
-	if len(pair) > 4 && strings.HasSuffix(pair, "USDT") {
-		return pair[:len(pair)-4] + "_USDT"
-	}
-	return pair
+	return levels
 }
 
-func (m *MexcAdapter) fetchTicker(ctx context.Context, pair string) (*mexcTicker, error) {
+func (m *MexcAdapter) fetchTicker(ctx context.Context, pair symbol.Pair) (*mexcTicker, error) {
 	url := fmt.Sprintf(
 		"https://contract.mexc.com/api/v1/contract/ticker?symbol=%s",
-		toMexcSymbol(pair),
+		m.codec.Encode(pair),
 	)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -0,0 +1,124 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+const mexcStreamURL = "wss://contract.mexc.com/edge"
+
+// StreamTicker subscribes to MEXC's ticker channel for pair and emits best
+// bid/ask updates on the returned channel until ctx is cancelled.
+func (m *MexcAdapter) StreamTicker(ctx context.Context, pair symbol.Pair) (<-chan *models.TickerUpdate, error) {
+	out := make(chan *models.TickerUpdate)
+	nativeSymbol := m.codec.Encode(pair)
+
+	go func() {
+		defer close(out)
+
+		mexcDialAndRead(ctx, "sub.ticker", nativeSymbol, func(raw json.RawMessage) {
+			var msg struct {
+				Channel string `json:"channel"`
+				Data    struct {
+					Bid1 float64 `json:"bid1"`
+					Ask1 float64 `json:"ask1"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				log.Warn().Err(err).Str("exchange", "mexc").Msg("failed to parse ticker message")
+				return
+			}
+			if msg.Channel != "push.ticker" {
+				return
+			}
+
+			out <- &models.TickerUpdate{
+				Exchange: "mexc",
+				Pair:     pair,
+				Bid:      msg.Data.Bid1,
+				Ask:      msg.Data.Ask1,
+				At:       time.Now(),
+			}
+		})
+	}()
+
+	return out, nil
+}
+
+// StreamOrderBook subscribes to MEXC's depth channel for pair and emits
+// the bid/ask ladder on the returned channel until ctx is cancelled.
+func (m *MexcAdapter) StreamOrderBook(ctx context.Context, pair symbol.Pair) (<-chan *models.OrderBookDepth, error) {
+	out := make(chan *models.OrderBookDepth)
+	nativeSymbol := m.codec.Encode(pair)
+
+	go func() {
+		defer close(out)
+
+		mexcDialAndRead(ctx, "sub.depth", nativeSymbol, func(raw json.RawMessage) {
+			var msg struct {
+				Channel string `json:"channel"`
+				Data    struct {
+					Bids [][]float64 `json:"bids"`
+					Asks [][]float64 `json:"asks"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				log.Warn().Err(err).Str("exchange", "mexc").Msg("failed to parse depth message")
+				return
+			}
+			if msg.Channel != "push.depth" {
+				return
+			}
+
+			out <- &models.OrderBookDepth{
+				Exchange: "mexc",
+				Pair:     pair,
+				Bids:     mexcLevels(msg.Data.Bids),
+				Asks:     mexcLevels(msg.Data.Asks),
+			}
+		})
+	}()
+
+	return out, nil
+}
+
+// mexcDialAndRead maintains a subscription to channel for symbol on MEXC's
+// public futures WS endpoint, reconnecting with exponential backoff on
+// failure, and invokes handle with each frame's raw payload until ctx is
+// cancelled.
+func mexcDialAndRead(ctx context.Context, channel, symbol string, handle func(json.RawMessage)) {
+	backoff := time.Second
+	const maxBackoff = 30 * time.Second
+
+	for ctx.Err() == nil {
+		conn, _, err := websocket.DefaultDialer.DialContext(ctx, mexcStreamURL, nil)
+		if err != nil {
+			log.Warn().Err(err).Str("exchange", "mexc").Str("channel", channel).Msg("websocket dial failed, retrying")
+			if !sleepOrDone(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff, maxBackoff)
+			continue
+		}
+
+		sub := map[string]any{"method": channel, "param": map[string]string{"symbol": symbol}}
+		if err := conn.WriteJSON(sub); err != nil {
+			log.Warn().Err(err).Str("exchange", "mexc").Msg("failed to send subscribe frame")
+			conn.Close()
+			if !sleepOrDone(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff, maxBackoff)
+			continue
+		}
+
+		backoff = time.Second
+		readUntilError(ctx, conn, handle)
+	}
+}
@@ -0,0 +1,65 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	"github.com/suwandre/arbiter/internal/exchange/conformance"
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+func TestMexcAdapter_GetSpread(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/mexc/ticker/ok.json", 0)
+	adapter := NewMexcAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	spread, err := adapter.GetSpread(context.Background(), symbol.NewPair("BTC", "USDT"))
+	if err != nil {
+		t.Fatalf("GetSpread returned error: %v", err)
+	}
+
+	if spread.Bid != 64999.5 || spread.Ask != 65000.5 {
+		t.Errorf("Bid/Ask = %v/%v, want 64999.5/65000.5", spread.Bid, spread.Ask)
+	}
+}
+
+func TestMexcAdapter_GetFundingRate(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/mexc/ticker/ok.json", 0)
+	adapter := NewMexcAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	funding, err := adapter.GetFundingRate(context.Background(), symbol.NewPair("BTC", "USDT"))
+	if err != nil {
+		t.Fatalf("GetFundingRate returned error: %v", err)
+	}
+
+	if funding.Rate != 0.0001 {
+		t.Errorf("Rate = %v, want 0.0001", funding.Rate)
+	}
+}
+
+func TestMexcAdapter_GetSpread_CodeError(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/mexc/ticker/code_error.json", 0)
+	adapter := NewMexcAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	if _, err := adapter.GetSpread(context.Background(), symbol.NewPair("BTC", "USDT")); err == nil {
+		t.Fatal("GetSpread with a non-zero API code: want error, got nil")
+	}
+}
+
+func TestMexcAdapter_GetOrderBookDepth(t *testing.T) {
+	server := conformance.NewFixtureServer(t, "testdata/vectors/mexc/depth/ok.json", 0)
+	adapter := NewMexcAdapterForTesting(conformance.RedirectingClient(t, server))
+
+	depth, err := adapter.GetOrderBookDepth(context.Background(), symbol.NewPair("BTC", "USDT"))
+	if err != nil {
+		t.Fatalf("GetOrderBookDepth returned error: %v", err)
+	}
+
+	const wantBidDepth = 194.9975
+	const wantAskDepth = 195.0025
+	bidDepth := models.NotionalDepth(depth.Bids)
+	askDepth := models.NotionalDepth(depth.Asks)
+	if bidDepth != wantBidDepth || askDepth != wantAskDepth {
+		t.Errorf("bid/ask depth = %v/%v, want %v/%v", bidDepth, askDepth, wantBidDepth, wantAskDepth)
+	}
+}
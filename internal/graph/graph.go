@@ -0,0 +1,278 @@
+// Package graph models the market as a directed multigraph so arbitrage
+// paths can be reasoned about generically, instead of the pairwise
+// comparisons in internal/arbitrage. Each vertex is an asset held on a
+// specific exchange; each edge is either a tradable pair (converting one
+// asset into another at that exchange's current book) or a zero-cost
+// transfer between the same asset on two different exchanges, modeling
+// capital already pre-positioned across venues. Walking a cycle through
+// this graph captures both classic triangular arbitrage (three pair edges
+// on one exchange) and inter-venue spread arbitrage (pair edges connected
+// by transfer edges) with the same search.
+package graph
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/scorer"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// MaxHops bounds how deep FindArbitragePaths will ever search, regardless
+// of the caller-supplied maxHops. Depth-first fan-out over a
+// fully-connected transfer mesh grows combinatorially with depth, so this
+// keeps worst-case search cost bounded even if a caller (e.g. an HTTP query
+// parameter) passes an unreasonable value.
+const MaxHops = 6
+
+// maxPlausibleGainPerHop bounds how much value a single further hop could
+// plausibly add back, used by walk to prune a branch once no sequence of
+// its remaining hops could plausibly bring its running product back above
+// 1.0. 5% per hop is generous headroom above realistic cross-exchange
+// spreads, so this only prunes branches that are definitively hopeless.
+const maxPlausibleGainPerHop = 1.05
+
+// Asset is a currency/coin symbol, e.g. "BTC" or "USDT", independent of any
+// particular exchange or pair.
+type Asset string
+
+// Node is one vertex in the graph: a specific asset held on a specific
+// exchange.
+type Node struct {
+	Exchange string
+	Asset    Asset
+}
+
+// Edge is a directed conversion from one Node to another: walking it turns
+// an amount of the source node's asset into an amount of the destination
+// node's asset, at that edge's current book price, net of depth and fees.
+type Edge struct {
+	To Node
+
+	// Pair is the tradable pair this edge walks. It's the zero Pair for
+	// transfer edges between the same asset on two exchanges.
+	Pair symbol.Pair
+
+	// Levels are the order book levels consumed when walking this edge: the
+	// bid side when SellsBase is true, the ask side otherwise. nil for
+	// transfer edges, which are assumed to have unlimited capacity.
+	Levels []models.PriceLevel
+
+	// SellsBase is true when this edge consumes the pair's base asset
+	// (selling it into Levels, the bid side, for quote) and false when it
+	// consumes the quote asset (buying base with it against Levels, the
+	// ask side). Meaningless for transfer edges, which convert at par.
+	SellsBase bool
+
+	// FeeRate is the exchange's taker fee as a fraction (0.0004 for 4bps),
+	// charged once per pair edge. Always 0 for transfer edges.
+	FeeRate float64
+}
+
+// convert returns how much of e.To's asset comes out the other side of e
+// for amountIn units of the source asset going in, after the book's
+// depth-weighted price and e's taker fee. It returns 0 if the book can't
+// absorb any of amountIn.
+func (e Edge) convert(amountIn float64) float64 {
+	if e.Levels == nil {
+		return amountIn // transfer edge: same asset, different venue, no price or fee impact
+	}
+
+	var out float64
+	if e.SellsBase {
+		out = sellBaseIntoLevels(e.Levels, amountIn)
+	} else {
+		vwap, filledQuote := scorer.EffectivePrice(e.Levels, amountIn)
+		if vwap > 0 {
+			out = filledQuote / vwap
+		}
+	}
+	return out * (1 - e.FeeRate)
+}
+
+// sellBaseIntoLevels walks levels (best price first, as in an order book's
+// bid side) consuming up to baseQtyIn units of base, and returns the quote
+// proceeds. It's the base-quantity-denominated counterpart to
+// scorer.EffectivePrice, which is denominated in quote notional instead.
+func sellBaseIntoLevels(levels []models.PriceLevel, baseQtyIn float64) float64 {
+	var quoteOut, qtyFilled float64
+
+	for _, level := range levels {
+		if qtyFilled+level.Qty >= baseQtyIn {
+			quoteOut += (baseQtyIn - qtyFilled) * level.Price
+			qtyFilled = baseQtyIn
+			break
+		}
+		quoteOut += level.Qty * level.Price
+		qtyFilled += level.Qty
+	}
+
+	return quoteOut
+}
+
+// Path is one walk through the graph found by FindArbitragePaths.
+type Path struct {
+	Edges []Edge
+
+	// Product is FilledAmount / the amount FindArbitragePaths was called
+	// with; a genuine arbitrage opportunity has Product > 1.0.
+	Product float64
+
+	// FilledAmount is the quantity of the path's final asset actually
+	// carried through to the last hop, after fees and any depth shortfall
+	// along the way.
+	FilledAmount float64
+}
+
+// Graph is a directed multigraph of (exchange, asset) nodes, fed
+// incrementally as fresh quotes arrive. The zero value is not usable; use
+// NewGraph.
+type Graph struct {
+	mu           sync.RWMutex
+	adj          map[Node][]Edge
+	nodes        map[Node]bool
+	takerFeesBps map[string]float64
+}
+
+// NewGraph builds an empty Graph. takerFeesBps maps exchange name (as
+// returned by exchange.Exchange.Name()) to its taker fee in basis points;
+// exchanges missing an entry are treated as zero-fee, matching
+// arbitrage.NewDetector's convention.
+func NewGraph(takerFeesBps map[string]float64) *Graph {
+	return &Graph{
+		adj:          make(map[Node][]Edge),
+		nodes:        make(map[Node]bool),
+		takerFeesBps: takerFeesBps,
+	}
+}
+
+// UpsertQuote records a fresh order book snapshot for (exchange, pair),
+// updating only the pair's two directed edges and the transfer edges for
+// its two assets rather than rebuilding the graph. Safe to call from both
+// the REST refresh loop and streaming update handlers.
+func (g *Graph) UpsertQuote(exchange string, pair symbol.Pair, depth *models.OrderBookDepth) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	base := Node{Exchange: exchange, Asset: Asset(pair.Base)}
+	quote := Node{Exchange: exchange, Asset: Asset(pair.Quote)}
+	fee := g.feeRate(exchange)
+
+	g.nodes[base] = true
+	g.nodes[quote] = true
+
+	g.setEdge(base, Edge{To: quote, Pair: pair, Levels: depth.Bids, SellsBase: true, FeeRate: fee})
+	g.setEdge(quote, Edge{To: base, Pair: pair, Levels: depth.Asks, SellsBase: false, FeeRate: fee})
+
+	g.linkTransfers(base)
+	g.linkTransfers(quote)
+}
+
+// feeRate returns exchangeName's taker fee as a fraction (not bps).
+func (g *Graph) feeRate(exchangeName string) float64 {
+	return g.takerFeesBps[exchangeName] / 10000
+}
+
+// setEdge replaces the existing from->edge.To edge over edge.Pair, if any,
+// or appends edge as a new one.
+func (g *Graph) setEdge(from Node, edge Edge) {
+	edges := g.adj[from]
+	for i, existing := range edges {
+		if existing.To == edge.To && existing.Pair == edge.Pair {
+			edges[i] = edge
+			return
+		}
+	}
+	g.adj[from] = append(edges, edge)
+}
+
+// linkTransfers ensures a zero-cost, unlimited-depth transfer edge exists in
+// both directions between node and every other known node sharing its
+// asset on a different exchange.
+func (g *Graph) linkTransfers(node Node) {
+	for other := range g.nodes {
+		if other.Asset != node.Asset || other.Exchange == node.Exchange {
+			continue
+		}
+		g.setEdge(node, Edge{To: other})
+		g.setEdge(other, Edge{To: node})
+	}
+}
+
+// FindArbitragePaths walks the graph from every node holding from, up to
+// maxHops edges deep, and returns every path that lands on a node holding
+// to with a cumulative Product (after depth-weighted fills and taker fees)
+// greater than 1.0 — i.e. a genuine arbitrage opportunity for amountIn
+// units of from starting capital. from == to finds cycles, covering both
+// triangular (one exchange) and inter-venue (transfer-edge-connected)
+// arbitrage. Results are sorted highest Product first.
+func (g *Graph) FindArbitragePaths(from, to Asset, maxHops int, amountIn float64) []Path {
+	if maxHops > MaxHops {
+		maxHops = MaxHops
+	}
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var results []Path
+	for node := range g.nodes {
+		if node.Asset != from {
+			continue
+		}
+		g.walk(node, to, maxHops, amountIn, amountIn, nil, &results)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Product > results[j].Product })
+	return results
+}
+
+// walk extends path (which has carried amount of the current node's asset
+// since the start node held startAmount of it) across every outgoing edge
+// of current, recording any path of at least two hops landing on asset to
+// whose product exceeds 1.0. Branches where the book can't fill anything
+// are pruned immediately rather than explored to maxHops. So is any branch
+// landing back on asset to whose running product, even with
+// maxPlausibleGainPerHop optimistically applied to every hop still
+// remaining, can't plausibly recover above 1.0 — amount is denominated in
+// whatever asset current holds, which only shares startAmount's units (and
+// so is comparable by division) once we're back on to.
+func (g *Graph) walk(current Node, to Asset, hopsLeft int, startAmount, amount float64, path []Edge, results *[]Path) {
+	if len(path) >= 2 && current.Asset == to {
+		if product := amount / startAmount; product > 1.0 {
+			*results = append(*results, Path{
+				Edges:        append([]Edge(nil), path...),
+				Product:      product,
+				FilledAmount: amount,
+			})
+		}
+	}
+
+	if hopsLeft <= 0 {
+		return
+	}
+
+	for _, edge := range g.adj[current] {
+		out := edge.convert(amount)
+		if out <= 0 {
+			continue // book too thin to carry any value across this edge
+		}
+
+		remainingHops := hopsLeft - 1
+		if edge.To.Asset == to {
+			product := out / startAmount
+			if product*math.Pow(maxPlausibleGainPerHop, float64(remainingHops)) <= 1.0 {
+				continue // no plausible sequence of the remaining hops recovers this above 1.0
+			}
+		}
+
+		// Copy rather than append(path, edge) directly: sibling edges in
+		// this loop must not share (and corrupt) the same backing array.
+		extended := make([]Edge, len(path)+1)
+		copy(extended, path)
+		extended[len(path)] = edge
+
+		g.walk(edge.To, to, remainingHops, startAmount, out, extended, results)
+	}
+}
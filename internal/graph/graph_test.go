@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// TestFindArbitragePaths_CrossVenueDislocation seeds two venues with a BTC
+// price gap wide enough to survive zero fees and asserts the resulting
+// buy-low/sell-high cycle is actually reported with Product > 1.
+func TestFindArbitragePaths_CrossVenueDislocation(t *testing.T) {
+	g := NewGraph(nil) // zero-fee: isolates the price dislocation from fee effects
+	pair := symbol.NewPair("BTC", "USDT")
+
+	g.UpsertQuote("binance", pair, &models.OrderBookDepth{
+		Exchange: "binance",
+		Pair:     pair,
+		Bids:     []models.PriceLevel{{Price: 64990, Qty: 10}},
+		Asks:     []models.PriceLevel{{Price: 65000, Qty: 10}}, // cheap to buy BTC here
+	})
+	g.UpsertQuote("bybit", pair, &models.OrderBookDepth{
+		Exchange: "bybit",
+		Pair:     pair,
+		Bids:     []models.PriceLevel{{Price: 65500, Qty: 10}}, // expensive to sell BTC here
+		Asks:     []models.PriceLevel{{Price: 65510, Qty: 10}},
+	})
+
+	paths := g.FindArbitragePaths("USDT", "USDT", 3, 10_000)
+	if len(paths) == 0 {
+		t.Fatalf("FindArbitragePaths found no opportunity across a seeded %v/%v dislocation", 65000, 65500)
+	}
+
+	best := paths[0]
+	if best.Product <= 1.0 {
+		t.Errorf("Product = %v, want > 1.0", best.Product)
+	}
+}
+
+// TestFindArbitragePaths_NoDislocation confirms aligned books across venues
+// never produce a false-positive opportunity.
+func TestFindArbitragePaths_NoDislocation(t *testing.T) {
+	g := NewGraph(nil)
+	pair := symbol.NewPair("BTC", "USDT")
+
+	for _, exchange := range []string{"binance", "bybit"} {
+		g.UpsertQuote(exchange, pair, &models.OrderBookDepth{
+			Exchange: exchange,
+			Pair:     pair,
+			Bids:     []models.PriceLevel{{Price: 64995, Qty: 10}},
+			Asks:     []models.PriceLevel{{Price: 65005, Qty: 10}},
+		})
+	}
+
+	paths := g.FindArbitragePaths("USDT", "USDT", 3, 10_000)
+	if len(paths) != 0 {
+		t.Errorf("FindArbitragePaths = %d paths, want 0 for aligned books; best product %v", len(paths), paths[0].Product)
+	}
+}
+
+// TestFindArbitragePaths_NonPositiveMaxHopsTerminates guards against a
+// regression where a non-positive maxHops (e.g. a negative max_hops query
+// param) never decremented down to the walk's base case and recursed
+// forever across the zero-cost transfer mesh.
+func TestFindArbitragePaths_NonPositiveMaxHopsTerminates(t *testing.T) {
+	g := NewGraph(nil)
+	pair := symbol.NewPair("BTC", "USDT")
+	g.UpsertQuote("binance", pair, &models.OrderBookDepth{
+		Exchange: "binance",
+		Pair:     pair,
+		Bids:     []models.PriceLevel{{Price: 64995, Qty: 10}},
+		Asks:     []models.PriceLevel{{Price: 65005, Qty: 10}},
+	})
+	g.UpsertQuote("bybit", pair, &models.OrderBookDepth{
+		Exchange: "bybit",
+		Pair:     pair,
+		Bids:     []models.PriceLevel{{Price: 64995, Qty: 10}},
+		Asks:     []models.PriceLevel{{Price: 65005, Qty: 10}},
+	})
+
+	for _, maxHops := range []int{0, -1} {
+		done := make(chan struct{})
+		go func() {
+			g.FindArbitragePaths("USDT", "USDT", maxHops, 10_000)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("FindArbitragePaths(maxHops=%d) did not return within 2s", maxHops)
+		}
+	}
+}
+
+// TestFindArbitragePaths_ClampsMaxHops confirms an oversized maxHops is
+// capped at MaxHops rather than trusted verbatim.
+func TestFindArbitragePaths_ClampsMaxHops(t *testing.T) {
+	g := NewGraph(nil)
+	pair := symbol.NewPair("BTC", "USDT")
+	g.UpsertQuote("binance", pair, &models.OrderBookDepth{
+		Exchange: "binance",
+		Pair:     pair,
+		Bids:     []models.PriceLevel{{Price: 64990, Qty: 10}},
+		Asks:     []models.PriceLevel{{Price: 65000, Qty: 10}},
+	})
+	g.UpsertQuote("bybit", pair, &models.OrderBookDepth{
+		Exchange: "bybit",
+		Pair:     pair,
+		Bids:     []models.PriceLevel{{Price: 65500, Qty: 10}},
+		Asks:     []models.PriceLevel{{Price: 65510, Qty: 10}},
+	})
+
+	done := make(chan struct{})
+	go func() {
+		g.FindArbitragePaths("USDT", "USDT", 10_000, 10_000)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("FindArbitragePaths with an oversized maxHops did not return within 2s; MaxHops clamp is not working")
+	}
+}
@@ -0,0 +1,105 @@
+// Package httpx provides exchange-HTTP-client plumbing shared across
+// adapters: a weight-aware rate limiter and a retrying, circuit-breaking
+// http.RoundTripper. It exists because exchanges like Binance bill API
+// usage in per-endpoint "weight" units against a per-minute IP budget
+// rather than a flat requests-per-second cap, and a bare http.Client has no
+// way to respect that.
+package httpx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WeightedLimiter is a token-bucket rate limiter sized in weight units
+// rather than raw request counts. capacity tokens refill continuously over
+// refillInterval, mirroring Binance's "1200 weight per minute" IP budget.
+type WeightedLimiter struct {
+	mu           sync.Mutex
+	capacity     float64
+	tokens       float64
+	refillPerSec float64
+	lastRefill   time.Time
+}
+
+// NewWeightedLimiter builds a WeightedLimiter starting at full capacity,
+// refilling capacity tokens every refillInterval.
+func NewWeightedLimiter(capacity float64, refillInterval time.Duration) *WeightedLimiter {
+	return &WeightedLimiter{
+		capacity:     capacity,
+		tokens:       capacity,
+		refillPerSec: capacity / refillInterval.Seconds(),
+		lastRefill:   time.Now(),
+	}
+}
+
+// Reserve blocks until weight tokens are available, deducts them, and
+// returns nil — or returns ctx.Err() if ctx is cancelled first.
+func (l *WeightedLimiter) Reserve(ctx context.Context, weight float64) error {
+	for {
+		l.mu.Lock()
+		l.refill()
+
+		if l.tokens >= weight {
+			l.tokens -= weight
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((weight - l.tokens) / l.refillPerSec * float64(time.Second))
+		l.mu.Unlock()
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// refill tops up tokens based on elapsed time since the last refill. Caller
+// must hold l.mu.
+func (l *WeightedLimiter) refill() {
+	now := time.Now()
+	elapsed := now.Sub(l.lastRefill).Seconds()
+
+	l.tokens += elapsed * l.refillPerSec
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	l.lastRefill = now
+}
+
+// ReportUsedWeight reconciles the bucket against an exchange's
+// authoritative used-weight header (e.g. X-MBX-USED-WEIGHT-1M), in case
+// local accounting has drifted — for instance because another process
+// shares the same API key.
+func (l *WeightedLimiter) ReportUsedWeight(used float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	if remaining := l.capacity - used; remaining < l.tokens {
+		l.tokens = remaining
+	}
+}
+
+type weightContextKey struct{}
+
+// WithWeight attaches a request's weight cost to ctx, so a RetryTransport
+// built with a WeightedLimiter reserves the right amount of capacity
+// before firing the request. Callers that don't attach one are charged
+// RetryTransport's default Weight.
+func WithWeight(ctx context.Context, weight float64) context.Context {
+	return context.WithValue(ctx, weightContextKey{}, weight)
+}
+
+// weightFromContext returns the weight attached to ctx by WithWeight, or
+// fallback if none was attached.
+func weightFromContext(ctx context.Context, fallback float64) float64 {
+	if w, ok := ctx.Value(weightContextKey{}).(float64); ok {
+		return w
+	}
+	return fallback
+}
@@ -0,0 +1,239 @@
+package httpx
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// UsedWeightHeader is the header Binance returns on every request telling
+// the caller its current weight usage within the trailing 1-minute window.
+const UsedWeightHeader = "X-Mbx-Used-Weight-1M"
+
+// Stats summarizes a RetryTransport's activity since it was created.
+type Stats struct {
+	Requests     int64
+	Retries      int64
+	Failures     int64
+	BreakerTrips int64
+}
+
+// RetryTransport wraps Base with weight-aware rate limiting, jittered
+// exponential backoff on 5xx/429 responses, and a per-host circuit breaker
+// that trips after BreakerThreshold consecutive failures.
+type RetryTransport struct {
+	Base    http.RoundTripper
+	Limiter *WeightedLimiter
+
+	// Weight is the request weight charged when a request's context wasn't
+	// annotated via WithWeight.
+	Weight float64
+
+	MaxRetries  int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+
+	mu      sync.Mutex
+	stats   Stats
+	breaker map[string]*breakerState
+}
+
+type breakerState struct {
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+// NewRetryTransport builds a RetryTransport over base (http.DefaultTransport
+// if nil) charging against limiter, with sensible defaults for retries,
+// backoff, and circuit-breaking.
+func NewRetryTransport(base http.RoundTripper, limiter *WeightedLimiter) *RetryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RetryTransport{
+		Base:             base,
+		Limiter:          limiter,
+		Weight:           1,
+		MaxRetries:       3,
+		BaseBackoff:      250 * time.Millisecond,
+		MaxBackoff:       5 * time.Second,
+		BreakerThreshold: 5,
+		BreakerCooldown:  30 * time.Second,
+		breaker:          make(map[string]*breakerState),
+	}
+}
+
+// Stats returns a snapshot of t's activity counters.
+func (t *RetryTransport) Stats() Stats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stats
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+
+	if t.breakerOpen(host) {
+		return nil, fmt.Errorf("httpx: circuit open for %s", host)
+	}
+
+	if t.Limiter != nil {
+		weight := weightFromContext(req.Context(), t.Weight)
+		if err := t.Limiter.Reserve(req.Context(), weight); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp *http.Response
+	var err error
+	backoff := t.BaseBackoff
+
+	for attempt := 0; ; attempt++ {
+		t.recordRequest()
+		resp, err = t.Base.RoundTrip(req)
+
+		if err == nil {
+			t.reconcileWeight(resp)
+			if resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+				t.recordSuccess(host)
+				return resp, nil
+			}
+		}
+
+		t.recordFailure(host)
+
+		if attempt >= t.MaxRetries {
+			break
+		}
+
+		wait := backoffWithJitter(backoff, retryAfter(resp))
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		backoff = nextRetryBackoff(backoff, t.MaxBackoff)
+		t.recordRetry()
+	}
+
+	if err == nil {
+		err = fmt.Errorf("httpx: exhausted retries against %s, last status %d", req.URL, resp.StatusCode)
+		resp.Body.Close()
+		resp = nil
+	}
+	return resp, err
+}
+
+func (t *RetryTransport) recordRequest() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats.Requests++
+}
+
+func (t *RetryTransport) recordRetry() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats.Retries++
+}
+
+// reconcileWeight feeds resp's used-weight header back into the limiter, if
+// one is configured.
+func (t *RetryTransport) reconcileWeight(resp *http.Response) {
+	if t.Limiter == nil || resp == nil {
+		return
+	}
+	if raw := resp.Header.Get(UsedWeightHeader); raw != "" {
+		if used, err := strconv.ParseFloat(raw, 64); err == nil {
+			t.Limiter.ReportUsedWeight(used)
+		}
+	}
+}
+
+// breakerOpen reports whether host's circuit breaker is currently tripped.
+func (t *RetryTransport) breakerOpen(host string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b, ok := t.breaker[host]
+	return ok && time.Now().Before(b.openUntil)
+}
+
+// recordFailure increments host's consecutive-failure count, tripping its
+// breaker once BreakerThreshold is reached.
+func (t *RetryTransport) recordFailure(host string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.stats.Failures++
+
+	b, ok := t.breaker[host]
+	if !ok {
+		b = &breakerState{}
+		t.breaker[host] = b
+	}
+
+	b.consecutiveFailures++
+	if b.consecutiveFailures >= t.BreakerThreshold {
+		b.openUntil = time.Now().Add(t.BreakerCooldown)
+		b.consecutiveFailures = 0
+		t.stats.BreakerTrips++
+	}
+}
+
+// recordSuccess resets host's consecutive-failure count.
+func (t *RetryTransport) recordSuccess(host string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if b, ok := t.breaker[host]; ok {
+		b.consecutiveFailures = 0
+	}
+}
+
+// retryAfter parses resp's Retry-After header (seconds form) into a
+// Duration, returning 0 if absent or unparseable.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	raw := resp.Header.Get("Retry-After")
+	if raw == "" {
+		return 0
+	}
+	secs, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
+
+// backoffWithJitter adds up to 50% jitter to backoff and honors hint (e.g.
+// a Retry-After value) if it asks for longer.
+func backoffWithJitter(backoff, hint time.Duration) time.Duration {
+	jittered := backoff + time.Duration(rand.Int63n(int64(backoff)/2+1))
+	if hint > jittered {
+		return hint
+	}
+	return jittered
+}
+
+// nextRetryBackoff doubles cur, capped at max.
+func nextRetryBackoff(cur, max time.Duration) time.Duration {
+	next := cur * 2
+	if next > max {
+		return max
+	}
+	return next
+}
@@ -0,0 +1,114 @@
+package httpx
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// closeTrackingBody wraps an io.ReadCloser and records whether Close was
+// called, so tests can assert RetryTransport doesn't leak response bodies.
+type closeTrackingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeTrackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func newRetryTransport(rt http.RoundTripper) *RetryTransport {
+	t := NewRetryTransport(rt, nil)
+	t.MaxRetries = 2
+	t.BaseBackoff = time.Millisecond
+	t.MaxBackoff = time.Millisecond
+	return t
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransport_ExhaustedRetriesClosesBody(t *testing.T) {
+	bodies := []*closeTrackingBody{}
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := &closeTrackingBody{Reader: strings.NewReader("rate limited")}
+		bodies = append(bodies, body)
+		return &http.Response{StatusCode: http.StatusTooManyRequests, Body: body, Header: make(http.Header)}, nil
+	})
+
+	rt := newRetryTransport(base)
+	req := httptest.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+
+	resp, err := rt.RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries, got nil")
+	}
+	if resp != nil {
+		t.Fatalf("expected nil response after exhausting retries, got %+v", resp)
+	}
+
+	if len(bodies) != rt.MaxRetries+1 {
+		t.Fatalf("got %d attempts, want %d", len(bodies), rt.MaxRetries+1)
+	}
+	for i, body := range bodies {
+		if !body.closed {
+			t.Errorf("attempt %d: response body was not closed", i)
+		}
+	}
+}
+
+func TestRetryTransport_SucceedsAfterRetry(t *testing.T) {
+	attempt := 0
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempt++
+		if attempt == 1 {
+			return &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Body:       &closeTrackingBody{Reader: strings.NewReader("boom")},
+				Header:     make(http.Header),
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("ok")),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	rt := newRetryTransport(base)
+	req := httptest.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want 200", resp.StatusCode)
+	}
+	if attempt != 2 {
+		t.Errorf("attempts = %d, want 2", attempt)
+	}
+}
+
+func TestRetryTransport_PropagatesTransportError(t *testing.T) {
+	wantErr := errors.New("dial failed")
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, wantErr
+	})
+
+	rt := newRetryTransport(base)
+	req := httptest.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+
+	_, err := rt.RoundTrip(req)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("RoundTrip error = %v, want %v", err, wantErr)
+	}
+}
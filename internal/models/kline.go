@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+// KlineInterval is a candlestick width, modeled as a typed enum (similar to
+// goex's KlinePeriod) rather than a raw string so adapters and callers
+// share one vocabulary for valid intervals.
+type KlineInterval string
+
+const (
+	Kline1m  KlineInterval = "1m"
+	Kline5m  KlineInterval = "5m"
+	Kline15m KlineInterval = "15m"
+	Kline1h  KlineInterval = "1h"
+	Kline4h  KlineInterval = "4h"
+	Kline1d  KlineInterval = "1d"
+)
+
+// Kline is one OHLC candle for a pair over some KlineInterval.
+type Kline struct {
+	OpenTime  time.Time `json:"open_time"`
+	Open      float64   `json:"open"`
+	High      float64   `json:"high"`
+	Low       float64   `json:"low"`
+	Close     float64   `json:"close"`
+	Volume    float64   `json:"volume"`
+	CloseTime time.Time `json:"close_time"`
+}
+
+// KlineQuery is the pagination window a GetKlines call resolves to once
+// every KlineOption has been applied.
+type KlineQuery struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+}
+
+// KlineOption is a functional option for GetKlines, so callers can page
+// historical windows (start/end time, result limit) without breaking the
+// method signature as more options are added later.
+type KlineOption func(*KlineQuery)
+
+// WithStartTime restricts a GetKlines call to candles opening at or after t.
+func WithStartTime(t time.Time) KlineOption {
+	return func(q *KlineQuery) { q.StartTime = t }
+}
+
+// WithEndTime restricts a GetKlines call to candles opening at or before t.
+func WithEndTime(t time.Time) KlineOption {
+	return func(q *KlineQuery) { q.EndTime = t }
+}
+
+// WithLimit caps the number of candles a GetKlines call returns.
+func WithLimit(n int) KlineOption {
+	return func(q *KlineQuery) { q.Limit = n }
+}
+
+// ApplyKlineOptions folds opts into a KlineQuery, giving every GetKlines
+// implementation one place to turn variadic options into concrete request
+// parameters instead of duplicating the fold themselves.
+func ApplyKlineOptions(opts ...KlineOption) KlineQuery {
+	var q KlineQuery
+	for _, opt := range opts {
+		opt(&q)
+	}
+	return q
+}
@@ -1,37 +1,144 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/suwandre/arbiter/internal/symbol"
+)
 
 type FundingRate struct {
-	Exchange    string    `json:"exchange"`
-	Pair        string    `json:"pair"`
-	Rate        float64   `json:"rate"`
-	NextFunding time.Time `json:"next_funding"`
+	Exchange    string      `json:"exchange"`
+	Pair        symbol.Pair `json:"pair"`
+	Rate        float64     `json:"rate"`
+	NextFunding time.Time   `json:"next_funding"`
 }
 
 type Spread struct {
-	Exchange string  `json:"exchange"`
-	Pair     string  `json:"pair"`
-	Bid      float64 `json:"bid"`
-	Ask      float64 `json:"ask"`
-	Spread   float64 `json:"spread"` // Ask - Bid
+	Exchange string      `json:"exchange"`
+	Pair     symbol.Pair `json:"pair"`
+	Bid      float64     `json:"bid"`
+	Ask      float64     `json:"ask"`
+	Spread   float64     `json:"spread"` // Ask - Bid
+}
+
+// PriceLevel is one rung of an order book ladder.
+type PriceLevel struct {
+	Price float64 `json:"price"`
+	Qty   float64 `json:"qty"` // quantity in base-asset units
+}
+
+// NotionalDepth sums Price*Qty across levels, giving the total quote-asset
+// liquidity they represent.
+func NotionalDepth(levels []PriceLevel) float64 {
+	total := 0.0
+	for _, level := range levels {
+		total += level.Price * level.Qty
+	}
+	return total
 }
 
 type OrderBookDepth struct {
-	Exchange string  `json:"exchange"`
-	Pair     string  `json:"pair"`
-	BidDepth float64 `json:"bid_depth"` // total liquidity on buy side
-	AskDepth float64 `json:"ask_depth"` // total liquidity on sell side
+	Exchange string       `json:"exchange"`
+	Pair     symbol.Pair  `json:"pair"`
+	Bids     []PriceLevel `json:"bids"` // best (highest) price first
+	Asks     []PriceLevel `json:"asks"` // best (lowest) price first
+}
+
+// SlippagePoint is the execution-quality cost of filling NotionalUSD against
+// one side of the book, expressed as basis points away from the best price.
+type SlippagePoint struct {
+	NotionalUSD float64 `json:"notional_usd"`
+	Bps         float64 `json:"bps"`
 }
 
 type ExchangeScore struct {
-	Exchange       string    `json:"exchange"`
-	Pair           string    `json:"pair"`
-	FundingRate    float64   `json:"funding_rate"`
-	SpreadPct      float64   `json:"spread_pct"`
-	RawBidDepth    float64   `json:"raw_bid_depth"`
-	RawAskDepth    float64   `json:"raw_ask_depth"`
-	DepthScore     float64   `json:"depth_score"`
-	CompositeScore float64   `json:"composite_score"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	Exchange       string          `json:"exchange"`
+	Pair           symbol.Pair     `json:"pair"`
+	FundingRate    float64         `json:"funding_rate"`
+	RawBid         float64         `json:"raw_bid"`
+	RawAsk         float64         `json:"raw_ask"`
+	SpreadPct      float64         `json:"spread_pct"`
+	RawBidDepth    float64         `json:"raw_bid_depth"`
+	RawAskDepth    float64         `json:"raw_ask_depth"`
+	DepthScore     float64         `json:"depth_score"`
+	SlippageBps    []SlippagePoint `json:"slippage_bps"`
+	Volatility     float64         `json:"volatility"` // stddev of recent kline returns; 0 if unavailable
+	CompositeScore float64         `json:"composite_score"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// TickerUpdate is a push-based best-bid/ask update delivered by a
+// StreamingExchange. It mirrors Spread but is named separately since
+// streaming payloads don't always carry a pre-computed Spread field.
+type TickerUpdate struct {
+	Exchange string      `json:"exchange"`
+	Pair     symbol.Pair `json:"pair"`
+	Bid      float64     `json:"bid"`
+	Ask      float64     `json:"ask"`
+	At       time.Time   `json:"at"`
+}
+
+// Balance is one asset's account balance on an exchange, as returned by a
+// PrivateExchange's GetAccountBalance.
+type Balance struct {
+	Exchange string  `json:"exchange"`
+	Asset    string  `json:"asset"`
+	Free     float64 `json:"free"`
+	Locked   float64 `json:"locked"`
+}
+
+// PositionSide distinguishes a long perpetual futures position from a
+// short one.
+type PositionSide string
+
+const (
+	PositionSideLong  PositionSide = "long"
+	PositionSideShort PositionSide = "short"
+)
+
+// Position is an open perpetual futures position on an exchange, as
+// returned by a PrivateExchange's GetOpenPositions.
+type Position struct {
+	Exchange      string       `json:"exchange"`
+	Pair          symbol.Pair  `json:"pair"`
+	Side          PositionSide `json:"side"`
+	Size          float64      `json:"size"` // base-asset units, always positive
+	EntryPrice    float64      `json:"entry_price"`
+	UnrealizedPnL float64      `json:"unrealized_pnl"`
+}
+
+// OrderSide is the direction of an OrderRequest.
+type OrderSide string
+
+const (
+	OrderSideBuy  OrderSide = "BUY"
+	OrderSideSell OrderSide = "SELL"
+)
+
+// OrderType is the execution style of an OrderRequest.
+type OrderType string
+
+const (
+	OrderTypeMarket OrderType = "MARKET"
+	OrderTypeLimit  OrderType = "LIMIT"
+)
+
+// OrderRequest describes an order to place on an exchange via
+// PrivateExchange.PlaceOrder. Price is ignored for market orders.
+type OrderRequest struct {
+	Pair     symbol.Pair `json:"pair"`
+	Side     OrderSide   `json:"side"`
+	Type     OrderType   `json:"type"`
+	Quantity float64     `json:"quantity"`
+	Price    float64     `json:"price,omitempty"`
+}
+
+// OrderAck is an exchange's acknowledgement of a placed order.
+type OrderAck struct {
+	Exchange  string    `json:"exchange"`
+	OrderID   string    `json:"order_id"`
+	Status    string    `json:"status"`
+	FilledQty float64   `json:"filled_qty"`
+	AvgPrice  float64   `json:"avg_price"`
+	At        time.Time `json:"at"`
 }
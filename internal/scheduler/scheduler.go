@@ -6,37 +6,70 @@ import (
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"github.com/suwandre/arbiter/internal/arbitrage"
+	"github.com/suwandre/arbiter/internal/exchange"
+	"github.com/suwandre/arbiter/internal/graph"
 	"github.com/suwandre/arbiter/internal/models"
 	"github.com/suwandre/arbiter/internal/scorer"
+	"github.com/suwandre/arbiter/internal/storage"
+	"github.com/suwandre/arbiter/internal/symbol"
 )
 
+// debounceInterval bounds how often a burst of streaming updates for the
+// same pair is allowed to trigger a score recompute.
+const debounceInterval = 250 * time.Millisecond
+
 type Scheduler struct {
-	scorer   *scorer.Scorer
-	pairs    []string
-	interval time.Duration
-	cache    map[string][]*models.ExchangeScore
-	mu       sync.RWMutex
-	cancel   context.CancelFunc
-	wg       sync.WaitGroup
+	scorer    *scorer.Scorer
+	exchanges []exchange.Exchange
+	pairs     []symbol.Pair
+	interval  time.Duration
+	store     storage.Store // optional; nil disables history persistence
+	detector  *arbitrage.Detector
+	graph     *graph.Graph // optional; nil disables the /v1/graph/paths endpoint
+	cache     map[symbol.Pair][]*models.ExchangeScore
+	arbCache  map[symbol.Pair][]arbitrage.Opportunity
+	mu        sync.RWMutex
+	cancel    context.CancelFunc
+	runCtx    context.Context
+	wg        sync.WaitGroup
+
+	debounceMu     sync.Mutex
+	debounceTimers map[symbol.Pair]*time.Timer
 }
 
-func NewScheduler(scorer *scorer.Scorer, pairs []string, interval time.Duration) *Scheduler {
+// NewScheduler wires a Scheduler to scorer and exchanges. store may be nil,
+// in which case score snapshots are cached in memory only and no history
+// is persisted.
+func NewScheduler(scorer *scorer.Scorer, exchanges []exchange.Exchange, pairs []symbol.Pair, interval time.Duration, store storage.Store, detector *arbitrage.Detector, graph *graph.Graph) *Scheduler {
 	return &Scheduler{
-		scorer:   scorer,
-		pairs:    pairs,
-		interval: interval,
-		cache:    make(map[string][]*models.ExchangeScore),
+		scorer:         scorer,
+		exchanges:      exchanges,
+		pairs:          pairs,
+		interval:       interval,
+		store:          store,
+		detector:       detector,
+		graph:          graph,
+		cache:          make(map[symbol.Pair][]*models.ExchangeScore),
+		arbCache:       make(map[symbol.Pair][]arbitrage.Opportunity),
+		debounceTimers: make(map[symbol.Pair]*time.Timer),
 	}
 }
 
-// Begins the polling loop in a background goroutine.
+// Begins the REST polling loop and, for any exchange that supports it, the
+// streaming subscriptions, both in background goroutines.
 func (s *Scheduler) Start(parentCtx context.Context) {
 	ctx, cancel := context.WithCancel(parentCtx)
 	s.cancel = cancel
+	s.runCtx = ctx
 
-	// Run immediately once so cache isn't empty on start
+	// Run immediately once so cache isn't empty on start, and so every
+	// exchange's live state has a REST-sourced baseline before any
+	// streaming updates arrive.
 	s.refresh(ctx)
 
+	s.startStreaming(ctx)
+
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
@@ -54,23 +87,34 @@ func (s *Scheduler) Start(parentCtx context.Context) {
 		}
 	}()
 
+	pairStrs := make([]string, len(s.pairs))
+	for i, p := range s.pairs {
+		pairStrs[i] = p.String()
+	}
+
 	log.Info().
 		Stringer("interval", s.interval).
-		Strs("pairs", s.pairs).
+		Strs("pairs", pairStrs).
 		Msg("scheduler started")
 }
 
-// Signals the background goroutine to exit cleanly.
+// Signals all background goroutines to exit cleanly.
 func (s *Scheduler) Stop() {
 	s.cancel()
-	// blocks until the goroutine fully exits.
+	// blocks until the goroutines fully exit.
 	// if refresh() is running midway when main() exits, Stop() is blocked until the goroutine finishes,
 	// ensuring that the HTTP request finishes and the cache is updated before the goroutine exits.
 	s.wg.Wait()
+
+	s.debounceMu.Lock()
+	for _, t := range s.debounceTimers {
+		t.Stop()
+	}
+	s.debounceMu.Unlock()
 }
 
 // Returns the latest cached scores for a pair.
-func (s *Scheduler) GetScores(pair string) ([]*models.ExchangeScore, bool) {
+func (s *Scheduler) GetScores(pair symbol.Pair) ([]*models.ExchangeScore, bool) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -78,12 +122,25 @@ func (s *Scheduler) GetScores(pair string) ([]*models.ExchangeScore, bool) {
 	return scores, ok
 }
 
-// Fetches fresh scores for all pairs and updates the cache.
+// Returns the latest detected arbitrage opportunities for a pair.
+func (s *Scheduler) GetOpportunities(pair symbol.Pair) ([]arbitrage.Opportunity, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	opportunities, ok := s.arbCache[pair]
+	return opportunities, ok
+}
+
+// Fetches fresh scores for all pairs via REST and updates the cache. This
+// doubles as the fallback path for exchanges that don't implement
+// exchange.StreamingExchange, and as the cold-start/reconnect baseline for
+// those that do (fetchAndScore seeds the scorer's live state as a side
+// effect).
 func (s *Scheduler) refresh(ctx context.Context) {
 	for _, pair := range s.pairs {
 		scores, err := s.scorer.ScoreAll(ctx, pair)
 		if err != nil {
-			log.Error().Err(err).Str("pair", pair).Msg("scheduler refresh failed")
+			log.Error().Err(err).Str("pair", pair.String()).Msg("scheduler refresh failed")
 			continue
 		}
 
@@ -91,6 +148,173 @@ func (s *Scheduler) refresh(ctx context.Context) {
 		s.cache[pair] = scores
 		s.mu.Unlock()
 
-		log.Info().Str("pair", pair).Int("exchanges", len(scores)).Msg("cache refreshed")
+		s.persist(ctx, scores)
+		s.detectOpportunities(pair, scores)
+		s.updateGraph(pair)
+
+		log.Info().Str("pair", pair.String()).Int("exchanges", len(scores)).Msg("cache refreshed")
+	}
+}
+
+// updateGraph feeds the latest ingested order book for every exchange on
+// pair into the graph, if one is configured. It's called after each REST
+// refresh; streaming depth updates are upserted directly by streamOrderBook.
+// Either way the graph stays current without ever being rebuilt from
+// scratch.
+func (s *Scheduler) updateGraph(pair symbol.Pair) {
+	if s.graph == nil {
+		return
+	}
+
+	for _, ex := range s.exchanges {
+		depth, ok := s.scorer.LatestDepth(pair, ex.Name())
+		if !ok {
+			continue
+		}
+		s.graph.UpsertQuote(ex.Name(), pair, depth)
+	}
+}
+
+// FindArbitragePaths exposes the configured graph's path search. ok is
+// false when no graph was wired into the scheduler at all, so callers can
+// distinguish "no graph configured" from "no paths found".
+func (s *Scheduler) FindArbitragePaths(from, to graph.Asset, maxHops int, notionalUSD float64) (paths []graph.Path, ok bool) {
+	if s.graph == nil {
+		return nil, false
+	}
+	return s.graph.FindArbitragePaths(from, to, maxHops, notionalUSD), true
+}
+
+// detectOpportunities runs the configured arbitrage.Detector (if any) over
+// scores and caches the ranked result for pair.
+func (s *Scheduler) detectOpportunities(pair symbol.Pair, scores []*models.ExchangeScore) {
+	if s.detector == nil {
+		return
+	}
+
+	opportunities := s.detector.Detect(pair, scores)
+
+	s.mu.Lock()
+	s.arbCache[pair] = opportunities
+	s.mu.Unlock()
+}
+
+// persist writes each score snapshot to the configured Store, if any. Store
+// errors are logged and otherwise ignored — history is a nice-to-have, not
+// something that should take down the live cache path.
+func (s *Scheduler) persist(ctx context.Context, scores []*models.ExchangeScore) {
+	if s.store == nil {
+		return
+	}
+
+	for _, score := range scores {
+		if err := s.store.SaveScore(ctx, score); err != nil {
+			log.Warn().Err(err).Str("exchange", score.Exchange).Str("pair", score.Pair.String()).Msg("failed to persist score snapshot")
+		}
+	}
+}
+
+// startStreaming subscribes to ticker and order book updates for every
+// (exchange, pair) combination where the exchange implements
+// exchange.StreamingExchange, driving the cache off push updates instead of
+// waiting for the next REST poll.
+func (s *Scheduler) startStreaming(ctx context.Context) {
+	for _, ex := range s.exchanges {
+		if streaming, ok := ex.(exchange.StreamingExchange); ok {
+			for _, pair := range s.pairs {
+				s.wg.Add(1)
+				go s.streamTicker(ctx, streaming, pair)
+
+				s.wg.Add(1)
+				go s.streamOrderBook(ctx, streaming, pair)
+			}
+		}
+
+		if fundingStreaming, ok := ex.(exchange.FundingStreamingExchange); ok {
+			for _, pair := range s.pairs {
+				s.wg.Add(1)
+				go s.streamFundingRate(ctx, fundingStreaming, pair)
+			}
+		}
+	}
+}
+
+func (s *Scheduler) streamTicker(ctx context.Context, ex exchange.StreamingExchange, pair symbol.Pair) {
+	defer s.wg.Done()
+
+	updates, err := ex.StreamTicker(ctx, pair)
+	if err != nil {
+		log.Error().Err(err).Str("exchange", ex.Name()).Str("pair", pair.String()).Msg("failed to start ticker stream")
+		return
+	}
+
+	for update := range updates {
+		s.scorer.IngestTicker(update)
+		s.debounceRecompute(pair)
+	}
+}
+
+func (s *Scheduler) streamOrderBook(ctx context.Context, ex exchange.StreamingExchange, pair symbol.Pair) {
+	defer s.wg.Done()
+
+	updates, err := ex.StreamOrderBook(ctx, pair)
+	if err != nil {
+		log.Error().Err(err).Str("exchange", ex.Name()).Str("pair", pair.String()).Msg("failed to start depth stream")
+		return
+	}
+
+	for depth := range updates {
+		s.scorer.IngestOrderBook(depth)
+		if s.graph != nil {
+			s.graph.UpsertQuote(ex.Name(), pair, depth)
+		}
+		s.debounceRecompute(pair)
+	}
+}
+
+func (s *Scheduler) streamFundingRate(ctx context.Context, ex exchange.FundingStreamingExchange, pair symbol.Pair) {
+	defer s.wg.Done()
+
+	updates, err := ex.StreamFundingRate(ctx, pair)
+	if err != nil {
+		log.Error().Err(err).Str("exchange", ex.Name()).Str("pair", pair.String()).Msg("failed to start funding rate stream")
+		return
+	}
+
+	for funding := range updates {
+		s.scorer.IngestFunding(funding)
+		s.debounceRecompute(pair)
+	}
+}
+
+// debounceRecompute schedules a ScoreFromState recompute for pair after
+// debounceInterval of quiet, coalescing bursts of streaming updates into a
+// single recompute instead of one per message.
+func (s *Scheduler) debounceRecompute(pair symbol.Pair) {
+	s.debounceMu.Lock()
+	defer s.debounceMu.Unlock()
+
+	if t, ok := s.debounceTimers[pair]; ok {
+		t.Reset(debounceInterval)
+		return
 	}
+
+	s.debounceTimers[pair] = time.AfterFunc(debounceInterval, func() {
+		s.recomputeFromState(pair)
+	})
+}
+
+func (s *Scheduler) recomputeFromState(pair symbol.Pair) {
+	scores, err := s.scorer.ScoreFromState(pair)
+	if err != nil {
+		log.Debug().Err(err).Str("pair", pair.String()).Msg("not enough live state yet to recompute from stream")
+		return
+	}
+
+	s.mu.Lock()
+	s.cache[pair] = scores
+	s.mu.Unlock()
+
+	s.persist(s.runCtx, scores)
+	s.detectOpportunities(pair, scores)
 }
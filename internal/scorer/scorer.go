@@ -3,16 +3,30 @@ package scorer
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/suwandre/arbiter/internal/exchange"
 	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
 )
 
 type Scorer struct {
 	exchanges []exchange.Exchange
+
+	mu   sync.RWMutex
+	live map[symbol.Pair]map[string]*liveState // pair -> exchange name -> state
+}
+
+// liveState holds the latest known funding/spread/depth for one
+// (exchange, pair), whether sourced from a REST poll or a streaming push.
+type liveState struct {
+	funding *models.FundingRate
+	spread  *models.Spread
+	depth   *models.OrderBookDepth
 }
 
 // Holds either a score or an error for one exchange.
@@ -22,12 +36,15 @@ type ExchangeResult struct {
 }
 
 func NewScorer(exchanges []exchange.Exchange) *Scorer {
-	return &Scorer{exchanges}
+	return &Scorer{
+		exchanges: exchanges,
+		live:      make(map[symbol.Pair]map[string]*liveState),
+	}
 }
 
 // Fetches data from all exchanges concurrently for a given pair
 // and returns a ranked slice of ExchangeScores.
-func (s *Scorer) ScoreAll(ctx context.Context, pair string) ([]*models.ExchangeScore, error) {
+func (s *Scorer) ScoreAll(ctx context.Context, pair symbol.Pair) ([]*models.ExchangeScore, error) {
 	results := make(chan ExchangeResult, len(s.exchanges))
 
 	var wg sync.WaitGroup
@@ -38,7 +55,7 @@ func (s *Scorer) ScoreAll(ctx context.Context, pair string) ([]*models.ExchangeS
 		go func(ex exchange.Exchange) {
 			defer wg.Done()
 
-			score, err := fetchAndScore(ctx, ex, pair)
+			score, err := s.fetchAndScore(ctx, ex, pair)
 			results <- ExchangeResult{Score: score, Err: err}
 		}(ex)
 	}
@@ -60,25 +77,20 @@ func (s *Scorer) ScoreAll(ctx context.Context, pair string) ([]*models.ExchangeS
 	}
 
 	if len(scores) == 0 {
-		return nil, fmt.Errorf("no exchange data available for pair %s", pair)
+		return nil, fmt.Errorf("no exchange data available for pair %s", pair.String())
 	}
 
-	// Normalize depth across exchanges before scoring
-	normalizeDepth(scores)
-
-	// Now compute composite with normalized depth
-	for _, score := range scores {
-		score.CompositeScore = (1/(1+score.FundingRate*100))*0.4 +
-			(1/(1+score.SpreadPct))*0.4 +
-			score.DepthScore*0.2
-	}
-
-	rankScores(scores)
+	NewDefaultWeighted().Apply(scores)
+	RankScores(scores)
 	return scores, nil
 }
 
 // Calls all three data endpoints for one exchange and computes its score.
-func fetchAndScore(ctx context.Context, ex exchange.Exchange, pair string) (*models.ExchangeScore, error) {
+// It also fetches klines for the volatility signal when ex implements
+// exchange.KlineExchange. As a side effect it seeds the live state map so
+// ScoreFromState has a baseline even before any streaming update arrives
+// for this pair.
+func (s *Scorer) fetchAndScore(ctx context.Context, ex exchange.Exchange, pair symbol.Pair) (*models.ExchangeScore, error) {
 	funding, err := ex.GetFundingRate(ctx, pair)
 	if err != nil {
 		return nil, fmt.Errorf("[%s] funding rate error: %w", ex.Name(), err)
@@ -94,36 +106,301 @@ func fetchAndScore(ctx context.Context, ex exchange.Exchange, pair string) (*mod
 		return nil, fmt.Errorf("[%s] depth error: %w", ex.Name(), err)
 	}
 
+	s.IngestFunding(funding)
+	s.IngestSpread(spread)
+	s.IngestOrderBook(depth)
+
 	spreadPct := 0.0
 	if spread.Bid > 0 {
 		spreadPct = (spread.Spread / spread.Bid) * 100
 	}
 
+	bidDepth := models.NotionalDepth(depth.Bids)
+	askDepth := models.NotionalDepth(depth.Asks)
+
 	return &models.ExchangeScore{
 		Exchange:    ex.Name(),
 		Pair:        pair,
 		FundingRate: funding.Rate,
+		RawBid:      spread.Bid,
+		RawAsk:      spread.Ask,
 		SpreadPct:   spreadPct,
-		RawBidDepth: depth.BidDepth,                  // raw bid depth
-		RawAskDepth: depth.AskDepth,                  // raw ask depth
-		DepthScore:  depth.BidDepth + depth.AskDepth, // raw depth score (sum of bid and ask depth), normalized later in ScoreALl
+		RawBidDepth: bidDepth,                    // raw bid depth
+		RawAskDepth: askDepth,                    // raw ask depth
+		DepthScore:  bidDepth + askDepth,         // raw depth score (sum of bid and ask depth); strategies normalize a copy and leave this field raw
+		SlippageBps: slippagePoints(depth.Asks), // execution cost of buying into the ask side
+		Volatility:  s.rollingVolatility(ctx, ex, pair),
 		UpdatedAt:   time.Now(),
 	}, nil
 }
 
-// Sorts scores in-place, highest CompositeScore first.
-func rankScores(scores []*models.ExchangeScore) {
-	for i := 0; i < len(scores)-1; i++ {
-		for j := i + 1; j < len(scores); j++ {
-			if scores[j].CompositeScore > scores[i].CompositeScore {
-				scores[i], scores[j] = scores[j], scores[i]
-			}
+// volatilityLookback/volatilityInterval bound the rolling-volatility sample
+// window used to penalize high-variance pairs in scoring.
+const volatilityLookback = 20
+
+var volatilityInterval = models.Kline1h
+
+// rollingVolatility returns the standard deviation of recent close-to-close
+// returns for (ex, pair), or 0 if ex doesn't implement exchange.KlineExchange
+// or the kline fetch fails — exchanges without a volatility signal are left
+// unpenalized rather than failing the whole score.
+func (s *Scorer) rollingVolatility(ctx context.Context, ex exchange.Exchange, pair symbol.Pair) float64 {
+	klineEx, ok := ex.(exchange.KlineExchange)
+	if !ok {
+		return 0
+	}
+
+	klines, err := klineEx.GetKlines(ctx, pair, volatilityInterval, models.WithLimit(volatilityLookback))
+	if err != nil {
+		log.Warn().Err(err).Str("exchange", ex.Name()).Msg("failed to fetch klines for volatility signal, skipping")
+		return 0
+	}
+
+	return stddevReturns(klines)
+}
+
+// stddevReturns computes the standard deviation of close-to-close returns
+// across klines, in closing order.
+func stddevReturns(klines []models.Kline) float64 {
+	if len(klines) < 2 {
+		return 0
+	}
+
+	returns := make([]float64, 0, len(klines)-1)
+	for i := 1; i < len(klines); i++ {
+		prev := klines[i-1].Close
+		if prev == 0 {
+			continue
+		}
+		returns = append(returns, (klines[i].Close-prev)/prev)
+	}
+	if len(returns) == 0 {
+		return 0
+	}
+
+	var mean float64
+	for _, r := range returns {
+		mean += r
+	}
+	mean /= float64(len(returns))
+
+	var variance float64
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean)
+	}
+	variance /= float64(len(returns))
+
+	return math.Sqrt(variance)
+}
+
+// tradeSizesUSD are the notional sizes, in USD, at which execution quality is
+// sampled when scoring an exchange's order book.
+var tradeSizesUSD = []float64{10_000, 100_000}
+
+// EffectivePrice walks levels (best price first) accumulating quantity until
+// notionalUSD of liquidity has been consumed, and returns the volume-weighted
+// average price actually paid along with the notional that could be filled
+// (which is less than notionalUSD if the book doesn't have enough depth).
+func EffectivePrice(levels []models.PriceLevel, notionalUSD float64) (vwap, filledUSD float64) {
+	var qtyFilled float64
+
+	for _, level := range levels {
+		levelNotional := level.Price * level.Qty
+		if filledUSD+levelNotional >= notionalUSD {
+			remaining := notionalUSD - filledUSD
+			qtyFilled += remaining / level.Price
+			filledUSD = notionalUSD
+			break
+		}
+		filledUSD += levelNotional
+		qtyFilled += level.Qty
+	}
+
+	if qtyFilled == 0 {
+		return 0, 0
+	}
+	return filledUSD / qtyFilled, filledUSD
+}
+
+// slippageAt returns the execution cost of filling notionalUSD against
+// levels, expressed in basis points away from the best price.
+func slippageAt(levels []models.PriceLevel, notionalUSD float64) float64 {
+	if len(levels) == 0 {
+		return 0
+	}
+
+	best := levels[0].Price
+	vwap, _ := EffectivePrice(levels, notionalUSD)
+	if vwap == 0 || best == 0 {
+		return 0
+	}
+	return ((vwap - best) / best) * 10000
+}
+
+// slippagePoints samples slippageAt across tradeSizesUSD, giving a
+// size-dependent view of execution quality for one side of the book.
+func slippagePoints(levels []models.PriceLevel) []models.SlippagePoint {
+	points := make([]models.SlippagePoint, 0, len(tradeSizesUSD))
+	for _, notional := range tradeSizesUSD {
+		points = append(points, models.SlippagePoint{
+			NotionalUSD: notional,
+			Bps:         slippageAt(levels, notional),
+		})
+	}
+	return points
+}
+
+// avgSlippageBps averages the bps cost across points, used to fold execution
+// quality into the composite score.
+func avgSlippageBps(points []models.SlippagePoint) float64 {
+	if len(points) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, p := range points {
+		total += p.Bps
+	}
+	return total / float64(len(points))
+}
+
+// stateFor returns the liveState for (pair, exchangeName), creating it if
+// this is the first update seen for that combination.
+func (s *Scorer) stateFor(pair symbol.Pair, exchangeName string) *liveState {
+	byExchange, ok := s.live[pair]
+	if !ok {
+		byExchange = make(map[string]*liveState)
+		s.live[pair] = byExchange
+	}
+
+	st, ok := byExchange[exchangeName]
+	if !ok {
+		st = &liveState{}
+		byExchange[exchangeName] = st
+	}
+	return st
+}
+
+// IngestTicker records a streaming best bid/ask update against the live
+// state for (update.Exchange, update.Pair).
+func (s *Scorer) IngestTicker(update *models.TickerUpdate) {
+	s.IngestSpread(&models.Spread{
+		Exchange: update.Exchange,
+		Pair:     update.Pair,
+		Bid:      update.Bid,
+		Ask:      update.Ask,
+		Spread:   update.Ask - update.Bid,
+	})
+}
+
+// IngestSpread records a bid/ask snapshot against the live state for
+// (spread.Exchange, spread.Pair).
+func (s *Scorer) IngestSpread(spread *models.Spread) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stateFor(spread.Pair, spread.Exchange).spread = spread
+}
+
+// IngestOrderBook records a depth snapshot against the live state for
+// (depth.Exchange, depth.Pair).
+func (s *Scorer) IngestOrderBook(depth *models.OrderBookDepth) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stateFor(depth.Pair, depth.Exchange).depth = depth
+}
+
+// IngestFunding records a funding rate snapshot against the live state for
+// (funding.Exchange, funding.Pair).
+func (s *Scorer) IngestFunding(funding *models.FundingRate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stateFor(funding.Pair, funding.Exchange).funding = funding
+}
+
+// LatestDepth returns the most recently ingested order book for
+// (exchangeName, pair), whether sourced from a REST poll or a streaming
+// push, and whether one has been ingested yet.
+func (s *Scorer) LatestDepth(pair symbol.Pair, exchangeName string) (*models.OrderBookDepth, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	byExchange, ok := s.live[pair]
+	if !ok {
+		return nil, false
+	}
+
+	st, ok := byExchange[exchangeName]
+	if !ok || st.depth == nil {
+		return nil, false
+	}
+	return st.depth, true
+}
+
+// ScoreFromState computes ranked ExchangeScores for pair purely from
+// previously-ingested live state, without making any network calls. It is
+// the push-driven counterpart to ScoreAll, meant to be called on a debounce
+// timer as streaming updates arrive. Exchanges missing any of the three
+// signals (e.g. mid-reconnect) are skipped.
+func (s *Scorer) ScoreFromState(pair symbol.Pair) ([]*models.ExchangeScore, error) {
+	s.mu.RLock()
+	byExchange := s.live[pair]
+	snapshot := make(map[string]liveState, len(byExchange))
+	for name, st := range byExchange {
+		snapshot[name] = *st
+	}
+	s.mu.RUnlock()
+
+	var scores []*models.ExchangeScore
+	for name, st := range snapshot {
+		if st.funding == nil || st.spread == nil || st.depth == nil {
+			continue
+		}
+
+		spreadPct := 0.0
+		if st.spread.Bid > 0 {
+			spreadPct = (st.spread.Spread / st.spread.Bid) * 100
 		}
+
+		bidDepth := models.NotionalDepth(st.depth.Bids)
+		askDepth := models.NotionalDepth(st.depth.Asks)
+
+		scores = append(scores, &models.ExchangeScore{
+			Exchange:    name,
+			Pair:        pair,
+			FundingRate: st.funding.Rate,
+			RawBid:      st.spread.Bid,
+			RawAsk:      st.spread.Ask,
+			SpreadPct:   spreadPct,
+			RawBidDepth: bidDepth,
+			RawAskDepth: askDepth,
+			DepthScore:  bidDepth + askDepth,
+			SlippageBps: slippagePoints(st.depth.Asks),
+			UpdatedAt:   time.Now(),
+		})
+	}
+
+	if len(scores) == 0 {
+		return nil, fmt.Errorf("no live state available yet for pair %s", pair.String())
 	}
+
+	NewDefaultWeighted().Apply(scores)
+	RankScores(scores)
+	return scores, nil
 }
 
-// Normalizes depth scores so they range from 0 to 1.
-func normalizeDepth(scores []*models.ExchangeScore) {
+// RankScores sorts scores in-place, highest CompositeScore first.
+func RankScores(scores []*models.ExchangeScore) {
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].CompositeScore > scores[j].CompositeScore
+	})
+}
+
+// normalizeDepth min-max normalizes DepthScore (raw summed bid+ask depth)
+// across scores to [0, 1], mirroring normalizeFunding. It returns the
+// normalized values rather than writing them back into DepthScore so that
+// field stays the raw liquidity figure callers persist (see
+// internal/storage/sqlite.go's SaveScore) instead of a value whose meaning
+// depends on which exchanges happened to be in the cohort being scored.
+func normalizeDepth(scores []*models.ExchangeScore) []float64 {
 	minD, maxD := scores[0].DepthScore, scores[0].DepthScore
 	for _, s := range scores[1:] {
 		if s.DepthScore < minD {
@@ -134,11 +411,13 @@ func normalizeDepth(scores []*models.ExchangeScore) {
 		}
 	}
 
-	for _, s := range scores {
+	norm := make([]float64, len(scores))
+	for i, s := range scores {
 		if maxD == minD {
-			s.DepthScore = 1.0 // all equal, give full score
-		} else {
-			s.DepthScore = (s.DepthScore - minD) / (maxD - minD)
+			norm[i] = 1.0 // all equal, give full score
+			continue
 		}
+		norm[i] = (s.DepthScore - minD) / (maxD - minD)
 	}
+	return norm
 }
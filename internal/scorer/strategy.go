@@ -0,0 +1,169 @@
+package scorer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/suwandre/arbiter/internal/models"
+)
+
+// ScoringStrategy ranks a snapshot of exchange scores by computing and
+// writing back each entry's CompositeScore. Strategies operate on the whole
+// slice, rather than one score at a time, because some signals (funding
+// rate, depth) only make sense normalized relative to the other exchanges
+// being compared.
+type ScoringStrategy interface {
+	Apply(scores []*models.ExchangeScore)
+}
+
+// DefaultWeighted blends funding rate, spread, depth, and execution quality.
+// The zero value is not meaningful on its own; use NewDefaultWeighted for the
+// module's default trader profile.
+type DefaultWeighted struct {
+	FundingW, SpreadW, DepthW, ExecutionW float64
+}
+
+// NewDefaultWeighted returns the module's default weighting, balanced
+// between funding-rate farming, tight spreads, book depth, and execution
+// quality.
+func NewDefaultWeighted() DefaultWeighted {
+	return DefaultWeighted{FundingW: 0.3, SpreadW: 0.3, DepthW: 0.2, ExecutionW: 0.2}
+}
+
+func (w DefaultWeighted) Apply(scores []*models.ExchangeScore) {
+	if len(scores) == 0 {
+		return
+	}
+
+	depthNorm := normalizeDepth(scores)
+	fundingNorm := normalizeFunding(scores)
+
+	for i, s := range scores {
+		executionQuality := 1 / (1 + avgSlippageBps(s.SlippageBps)/100)
+		s.CompositeScore = (fundingNorm[i]*w.FundingW +
+			(1/(1+s.SpreadPct))*w.SpreadW +
+			depthNorm[i]*w.DepthW +
+			executionQuality*w.ExecutionW) * volatilityPenalty(s.Volatility)
+	}
+}
+
+// FundingOnly ranks exchanges purely by normalized funding rate, for traders
+// only interested in funding-rate farming.
+type FundingOnly struct{}
+
+func (FundingOnly) Apply(scores []*models.ExchangeScore) {
+	if len(scores) == 0 {
+		return
+	}
+
+	fundingNorm := normalizeFunding(scores)
+	for i, s := range scores {
+		s.CompositeScore = fundingNorm[i]
+	}
+}
+
+// ExecutionQuality ranks exchanges purely by execution quality (inverse
+// average slippage across the sampled trade sizes), for takers who care
+// mainly about fill cost.
+type ExecutionQuality struct{}
+
+func (ExecutionQuality) Apply(scores []*models.ExchangeScore) {
+	for _, s := range scores {
+		s.CompositeScore = 1 / (1 + avgSlippageBps(s.SlippageBps)/100)
+	}
+}
+
+// UserWeights lets a caller supply their own funding/spread/depth weighting,
+// e.g. from a query parameter, without a fixed execution-quality term.
+type UserWeights struct {
+	FundingW, SpreadW, DepthW float64
+}
+
+func (w UserWeights) Apply(scores []*models.ExchangeScore) {
+	if len(scores) == 0 {
+		return
+	}
+
+	depthNorm := normalizeDepth(scores)
+	fundingNorm := normalizeFunding(scores)
+
+	for i, s := range scores {
+		s.CompositeScore = (fundingNorm[i]*w.FundingW +
+			(1/(1+s.SpreadPct))*w.SpreadW +
+			depthNorm[i]*w.DepthW) * volatilityPenalty(s.Volatility)
+	}
+}
+
+// ParseStrategy builds a ScoringStrategy from the strategy and weights query
+// parameters accepted by GET /v1/scores/:pair. weights is only consulted for
+// the "user_weights" strategy, where it's a "funding,spread,depth"
+// comma-separated triple, e.g. "0.5,0.3,0.2"; it's intentionally ignored
+// for every other strategy rather than erroring, so callers can pass a
+// stale or default weights param alongside strategy=funding_only without
+// it being treated as a mistake.
+func ParseStrategy(strategy, weights string) (ScoringStrategy, error) {
+	switch strategy {
+	case "", "default":
+		return NewDefaultWeighted(), nil
+	case "funding_only":
+		return FundingOnly{}, nil
+	case "execution_quality":
+		return ExecutionQuality{}, nil
+	case "user_weights":
+		parts := strings.Split(weights, ",")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("user_weights strategy requires weights=funding,spread,depth")
+		}
+
+		parsed := make([]float64, 3)
+		for i, p := range parts {
+			v, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight %q: %w", p, err)
+			}
+			parsed[i] = v
+		}
+		return UserWeights{FundingW: parsed[0], SpreadW: parsed[1], DepthW: parsed[2]}, nil
+	default:
+		return nil, fmt.Errorf("unknown scoring strategy %q", strategy)
+	}
+}
+
+// volatilityPenalty dampens CompositeScore for higher-variance pairs so
+// illiquid or choppy markets rank below otherwise-equal calmer ones.
+// Exchanges with no rolling-volatility signal (Volatility left at its zero
+// value, e.g. no KlineExchange support) are left unpenalized.
+func volatilityPenalty(volatility float64) float64 {
+	if volatility <= 0 {
+		return 1.0
+	}
+	return 1 / (1 + volatility*100)
+}
+
+// normalizeFunding min-max normalizes raw funding rates across scores to
+// [0, 1], inverted so the lowest (cheapest to hold) funding rate scores
+// 1.0 and the highest scores 0.0 — lower funding cost is better, matching
+// the baseline (1/(1+fr*100))*0.4 term this replaced. This also replaces
+// that baseline term's inverted sign and blowup for fr < -0.01.
+func normalizeFunding(scores []*models.ExchangeScore) []float64 {
+	minF, maxF := scores[0].FundingRate, scores[0].FundingRate
+	for _, s := range scores[1:] {
+		if s.FundingRate < minF {
+			minF = s.FundingRate
+		}
+		if s.FundingRate > maxF {
+			maxF = s.FundingRate
+		}
+	}
+
+	norm := make([]float64, len(scores))
+	for i, s := range scores {
+		if maxF == minF {
+			norm[i] = 1.0
+			continue
+		}
+		norm[i] = (maxF - s.FundingRate) / (maxF - minF)
+	}
+	return norm
+}
@@ -0,0 +1,60 @@
+package scorer
+
+import (
+	"testing"
+
+	"github.com/suwandre/arbiter/internal/models"
+)
+
+// TestNormalizeFunding_LowerRateRanksHigher pins funding normalization's
+// direction: a lower (cheaper to hold) funding rate must score higher,
+// since FundingOnly returns normalizeFunding's output directly as
+// CompositeScore and traders farming funding want to pay the least.
+func TestNormalizeFunding_LowerRateRanksHigher(t *testing.T) {
+	scores := []*models.ExchangeScore{
+		{Exchange: "cheap", FundingRate: -0.0005},
+		{Exchange: "expensive", FundingRate: 0.0010},
+	}
+
+	norm := normalizeFunding(scores)
+
+	if norm[0] <= norm[1] {
+		t.Errorf("normalizeFunding(%v) = %v, want lower funding rate (index 0) to score higher than index 1", scores, norm)
+	}
+}
+
+// TestDefaultWeighted_Apply_LowerFundingRanksHigher confirms the direction
+// pinned above survives into the composite score traders actually see.
+func TestDefaultWeighted_Apply_LowerFundingRanksHigher(t *testing.T) {
+	scores := []*models.ExchangeScore{
+		{Exchange: "cheap", FundingRate: -0.0005, SpreadPct: 0.01, DepthScore: 100},
+		{Exchange: "expensive", FundingRate: 0.0010, SpreadPct: 0.01, DepthScore: 100},
+	}
+
+	NewDefaultWeighted().Apply(scores)
+
+	if scores[0].CompositeScore <= scores[1].CompositeScore {
+		t.Errorf("CompositeScore = %v, want lower funding rate exchange to rank above higher funding rate exchange", scores)
+	}
+}
+
+// TestDefaultWeighted_Apply_PreservesRawDepthScore guards against
+// normalizeDepth mutating DepthScore in place: callers (e.g.
+// internal/storage.SaveScore) persist DepthScore expecting raw liquidity,
+// not a value normalized against whichever cohort happened to be scored
+// together.
+func TestDefaultWeighted_Apply_PreservesRawDepthScore(t *testing.T) {
+	scores := []*models.ExchangeScore{
+		{Exchange: "a", DepthScore: 100},
+		{Exchange: "b", DepthScore: 300},
+	}
+
+	NewDefaultWeighted().Apply(scores)
+
+	if scores[0].DepthScore != 100 {
+		t.Errorf("scores[0].DepthScore = %v, want unchanged raw value 100", scores[0].DepthScore)
+	}
+	if scores[1].DepthScore != 300 {
+		t.Errorf("scores[1].DepthScore = %v, want unchanged raw value 300", scores[1].DepthScore)
+	}
+}
@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/suwandre/arbiter/internal/models"
+)
+
+// SQLiteStore is the default Store backend: a single file (or in-memory)
+// SQLite database. It's the cheapest option to stand up for local dev and
+// small deployments; swap in a Postgres/TimescaleDB-backed Store for
+// production-scale retention without touching callers.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (creating if necessary) the SQLite database at path
+// and ensures the scores table exists. Pass ":memory:" for an ephemeral
+// store, e.g. in tests.
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("storage: failed to open sqlite db: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("storage: failed to connect to sqlite db: %w", err)
+	}
+
+	if _, err := db.Exec(createScoresTable); err != nil {
+		return nil, fmt.Errorf("storage: failed to create scores table: %w", err)
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+const createScoresTable = `
+CREATE TABLE IF NOT EXISTS scores (
+	exchange         TEXT NOT NULL,
+	pair             TEXT NOT NULL,
+	funding_rate     REAL NOT NULL,
+	spread_pct       REAL NOT NULL,
+	raw_bid_depth    REAL NOT NULL,
+	raw_ask_depth    REAL NOT NULL,
+	depth_score      REAL NOT NULL,
+	composite_score  REAL NOT NULL,
+	updated_at       INTEGER NOT NULL,
+	PRIMARY KEY (exchange, pair, updated_at)
+);
+CREATE INDEX IF NOT EXISTS idx_scores_pair_updated_at ON scores (pair, updated_at);
+`
+
+// SaveScore appends one ExchangeScore snapshot, keyed by
+// (exchange, pair, updated_at). updated_at is stored at nanosecond
+// granularity rather than truncated to the second: the push-driven scorer
+// can persist several recomputes per second for the same (exchange, pair)
+// (see scheduler.debounceInterval), and a second-granularity key would
+// silently collapse same-second recomputes via INSERT OR REPLACE before
+// ScoreHistory/FundingHistory ever saw them.
+func (s *SQLiteStore) SaveScore(ctx context.Context, score *models.ExchangeScore) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT OR REPLACE INTO scores
+			(exchange, pair, funding_rate, spread_pct, raw_bid_depth, raw_ask_depth, depth_score, composite_score, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		score.Exchange, score.Pair.String(), score.FundingRate, score.SpreadPct,
+		score.RawBidDepth, score.RawAskDepth, score.DepthScore, score.CompositeScore,
+		score.UpdatedAt.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("storage: failed to save score snapshot: %w", err)
+	}
+	return nil
+}
+
+// ScoreHistory aggregates stored snapshots into OHLC-style buckets of width
+// interval, one row per (exchange, bucket).
+func (s *SQLiteStore) ScoreHistory(ctx context.Context, pair string, from, to time.Time, interval time.Duration) ([]Bucket, error) {
+	intervalNanos := interval.Nanoseconds()
+	if intervalNanos <= 0 {
+		return nil, fmt.Errorf("storage: interval must be positive, got %s", interval)
+	}
+
+	// positioned ranks each row within its (exchange, bucket) group by
+	// updated_at, ascending and descending, so the outer query can pick out
+	// the genuine first/last row per bucket via conditional aggregation
+	// instead of conflating open/close with min/max. updated_at is stored
+	// at nanosecond granularity (see SaveScore), so bucketing divides by
+	// intervalNanos rather than intervalSec.
+	rows, err := s.db.QueryContext(ctx, `
+		WITH positioned AS (
+			SELECT
+				exchange,
+				(updated_at / ?) * ? AS bucket_at,
+				updated_at,
+				funding_rate,
+				spread_pct,
+				depth_score,
+				ROW_NUMBER() OVER (PARTITION BY exchange, (updated_at / ?) * ? ORDER BY updated_at ASC) AS rn_asc,
+				ROW_NUMBER() OVER (PARTITION BY exchange, (updated_at / ?) * ? ORDER BY updated_at DESC) AS rn_desc
+			FROM scores
+			WHERE pair = ? AND updated_at BETWEEN ? AND ?
+		)
+		SELECT
+			exchange,
+			bucket_at,
+			MIN(funding_rate), MAX(funding_rate),
+			MIN(spread_pct), MAX(spread_pct),
+			AVG(depth_score),
+			COUNT(*),
+			MAX(CASE WHEN rn_asc = 1 THEN funding_rate END),
+			MAX(CASE WHEN rn_desc = 1 THEN funding_rate END),
+			MAX(CASE WHEN rn_asc = 1 THEN spread_pct END),
+			MAX(CASE WHEN rn_desc = 1 THEN spread_pct END)
+		FROM positioned
+		GROUP BY exchange, bucket_at
+		ORDER BY bucket_at ASC`,
+		intervalNanos, intervalNanos, intervalNanos, intervalNanos, intervalNanos, intervalNanos,
+		pair, from.UnixNano(), to.UnixNano(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("storage: score history query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var buckets []Bucket
+	for rows.Next() {
+		var b Bucket
+		var bucketAt int64
+		b.Pair = pair
+
+		if err := rows.Scan(
+			&b.Exchange, &bucketAt, &b.LowFR, &b.HighFR, &b.LowSpr, &b.HighSpr, &b.AvgDepth, &b.SampleCnt,
+			&b.OpenFR, &b.CloseFR, &b.OpenSpr, &b.CloseSpr,
+		); err != nil {
+			return nil, fmt.Errorf("storage: failed to scan score history row: %w", err)
+		}
+
+		b.BucketAt = time.Unix(0, bucketAt).UTC()
+
+		buckets = append(buckets, b)
+	}
+
+	return buckets, rows.Err()
+}
+
+// FundingHistory aggregates stored snapshots into funding-rate-only OHLC
+// buckets of width interval, one row per (exchange, bucket).
+func (s *SQLiteStore) FundingHistory(ctx context.Context, pair string, from, to time.Time, interval time.Duration) ([]FundingBucket, error) {
+	intervalNanos := interval.Nanoseconds()
+	if intervalNanos <= 0 {
+		return nil, fmt.Errorf("storage: interval must be positive, got %s", interval)
+	}
+
+	// positioned ranks each row within its (exchange, bucket) group by
+	// updated_at, ascending and descending, so the outer query can pick out
+	// the genuine first/last row per bucket via conditional aggregation
+	// instead of conflating open/close with min/max. updated_at is stored
+	// at nanosecond granularity (see SaveScore), so bucketing divides by
+	// intervalNanos rather than intervalSec.
+	rows, err := s.db.QueryContext(ctx, `
+		WITH positioned AS (
+			SELECT
+				exchange,
+				(updated_at / ?) * ? AS bucket_at,
+				updated_at,
+				funding_rate,
+				ROW_NUMBER() OVER (PARTITION BY exchange, (updated_at / ?) * ? ORDER BY updated_at ASC) AS rn_asc,
+				ROW_NUMBER() OVER (PARTITION BY exchange, (updated_at / ?) * ? ORDER BY updated_at DESC) AS rn_desc
+			FROM scores
+			WHERE pair = ? AND updated_at BETWEEN ? AND ?
+		)
+		SELECT
+			exchange,
+			bucket_at,
+			MIN(funding_rate), MAX(funding_rate),
+			COUNT(*),
+			MAX(CASE WHEN rn_asc = 1 THEN funding_rate END),
+			MAX(CASE WHEN rn_desc = 1 THEN funding_rate END)
+		FROM positioned
+		GROUP BY exchange, bucket_at
+		ORDER BY bucket_at ASC`,
+		intervalNanos, intervalNanos, intervalNanos, intervalNanos, intervalNanos, intervalNanos,
+		pair, from.UnixNano(), to.UnixNano(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("storage: funding history query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var buckets []FundingBucket
+	for rows.Next() {
+		var b FundingBucket
+		var bucketAt int64
+		b.Pair = pair
+
+		if err := rows.Scan(&b.Exchange, &bucketAt, &b.Low, &b.High, &b.SampleCnt, &b.Open, &b.Close); err != nil {
+			return nil, fmt.Errorf("storage: failed to scan funding history row: %w", err)
+		}
+
+		b.BucketAt = time.Unix(0, bucketAt).UTC()
+
+		buckets = append(buckets, b)
+	}
+
+	return buckets, rows.Err()
+}
+
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
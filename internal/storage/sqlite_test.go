@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/suwandre/arbiter/internal/models"
+	"github.com/suwandre/arbiter/internal/symbol"
+)
+
+// TestSQLiteStore_SaveScore_SameSecondRecomputesSurvive guards against the
+// scores table PK truncating updated_at to the second: the push-driven
+// scorer can persist several recomputes per second for the same
+// (exchange, pair) (scheduler.debounceInterval is 250ms), so a second-level
+// key would silently collapse them via INSERT OR REPLACE.
+func TestSQLiteStore_SaveScore_SameSecondRecomputesSurvive(t *testing.T) {
+	store, err := NewSQLiteStore(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	defer store.Close()
+
+	pair := symbol.NewPair("BTC", "USDT")
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ctx := context.Background()
+	for i, rate := range []float64{0.0001, 0.0002, 0.0003, 0.0004} {
+		score := &models.ExchangeScore{
+			Exchange:    "binance",
+			Pair:        pair,
+			FundingRate: rate,
+			UpdatedAt:   base.Add(time.Duration(i) * 250 * time.Millisecond),
+		}
+		if err := store.SaveScore(ctx, score); err != nil {
+			t.Fatalf("SaveScore #%d: %v", i, err)
+		}
+	}
+
+	buckets, err := store.FundingHistory(ctx, pair.String(), base.Add(-time.Second), base.Add(time.Second), time.Minute)
+	if err != nil {
+		t.Fatalf("FundingHistory: %v", err)
+	}
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	if buckets[0].SampleCnt != 4 {
+		t.Errorf("SampleCnt = %d, want 4 (all same-second recomputes should persist)", buckets[0].SampleCnt)
+	}
+	if buckets[0].Open != 0.0001 || buckets[0].Close != 0.0004 {
+		t.Errorf("Open/Close = %v/%v, want 0.0001/0.0004", buckets[0].Open, buckets[0].Close)
+	}
+}
@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/suwandre/arbiter/internal/models"
+)
+
+// Store persists ExchangeScore snapshots and answers historical range
+// queries over them. Implementations are swappable backends (SQLite today,
+// Postgres/TimescaleDB are natural additions later) so the scheduler can
+// depend on the interface without caring where the data actually lives.
+type Store interface {
+	// SaveScore appends one ExchangeScore snapshot, keyed by
+	// (exchange, pair, updated_at).
+	SaveScore(ctx context.Context, score *models.ExchangeScore) error
+
+	// ScoreHistory returns OHLC-style buckets of width interval covering
+	// [from, to] for the given pair, one bucket per exchange per window.
+	ScoreHistory(ctx context.Context, pair string, from, to time.Time, interval time.Duration) ([]Bucket, error)
+
+	// FundingHistory returns funding-rate-only buckets of width interval
+	// covering [from, to] for the given pair.
+	FundingHistory(ctx context.Context, pair string, from, to time.Time, interval time.Duration) ([]FundingBucket, error)
+
+	Close() error
+}
+
+// Bucket is an OHLC-style aggregate of funding rate, spread, and depth over
+// one time window for one exchange.
+type Bucket struct {
+	Exchange  string    `json:"exchange"`
+	Pair      string    `json:"pair"`
+	BucketAt  time.Time `json:"bucket_at"`
+	OpenFR    float64   `json:"open_funding_rate"`
+	HighFR    float64   `json:"high_funding_rate"`
+	LowFR     float64   `json:"low_funding_rate"`
+	CloseFR   float64   `json:"close_funding_rate"`
+	OpenSpr   float64   `json:"open_spread_pct"`
+	HighSpr   float64   `json:"high_spread_pct"`
+	LowSpr    float64   `json:"low_spread_pct"`
+	CloseSpr  float64   `json:"close_spread_pct"`
+	AvgDepth  float64   `json:"avg_depth_score"`
+	SampleCnt int       `json:"sample_count"`
+}
+
+// FundingBucket is a funding-rate-only OHLC aggregate, returned by the
+// dedicated funding history endpoint.
+type FundingBucket struct {
+	Exchange  string    `json:"exchange"`
+	Pair      string    `json:"pair"`
+	BucketAt  time.Time `json:"bucket_at"`
+	Open      float64   `json:"open"`
+	High      float64   `json:"high"`
+	Low       float64   `json:"low"`
+	Close     float64   `json:"close"`
+	SampleCnt int       `json:"sample_count"`
+}
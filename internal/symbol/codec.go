@@ -0,0 +1,29 @@
+package symbol
+
+// SymbolCodec translates the canonical Pair representation into one
+// exchange's native symbol format. Each adapter owns the codec that matches
+// its own API instead of every caller re-deriving the native string (e.g.
+// the old package-level toMexcSymbol helper).
+type SymbolCodec interface {
+	// Encode renders pair in the exchange's native symbol format, e.g.
+	// BTC/USDT -> "BTCUSDT" for Binance, "BTC_USDT" for MEXC.
+	Encode(pair Pair) string
+}
+
+// ConcatCodec implements SymbolCodec for exchanges whose native symbols are
+// the base and quote concatenated with no separator (Binance and Bybit
+// futures both use this, e.g. "BTCUSDT").
+type ConcatCodec struct{}
+
+func (ConcatCodec) Encode(pair Pair) string {
+	return pair.Base + pair.Quote
+}
+
+// UnderscoreCodec implements SymbolCodec for exchanges whose native symbols
+// separate base and quote with an underscore (MEXC futures, e.g.
+// "BTC_USDT").
+type UnderscoreCodec struct{}
+
+func (UnderscoreCodec) Encode(pair Pair) string {
+	return pair.Base + "_" + pair.Quote
+}
@@ -0,0 +1,17 @@
+package symbol
+
+import "testing"
+
+func TestConcatCodec_Encode(t *testing.T) {
+	got := ConcatCodec{}.Encode(NewPair("BTC", "USDT"))
+	if got != "BTCUSDT" {
+		t.Errorf("Encode(BTC/USDT) = %q, want %q", got, "BTCUSDT")
+	}
+}
+
+func TestUnderscoreCodec_Encode(t *testing.T) {
+	got := UnderscoreCodec{}.Encode(NewPair("BTC", "USDT"))
+	if got != "BTC_USDT" {
+		t.Errorf("Encode(BTC/USDT) = %q, want %q", got, "BTC_USDT")
+	}
+}
@@ -0,0 +1,63 @@
+package symbol
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Pair is the canonical, exchange-agnostic representation of a tradable
+// market: a base asset quoted in another asset (e.g. BTC/USDT). Exchange,
+// Scorer, and Scheduler all key their state off Pair instead of each
+// exchange's native symbol string, so non-USDT-quoted markets (BTC/USDC,
+// ETH/BTC, KRW pairs, ...) aren't silently mishandled by suffix checks like
+// `strings.HasSuffix(pair, "USDT")`.
+type Pair struct {
+	Base  string
+	Quote string
+}
+
+// NewPair builds a canonical Pair, upper-casing both legs so "btc", "BTC",
+// and "Btc" all compare equal.
+func NewPair(base, quote string) Pair {
+	return Pair{Base: strings.ToUpper(base), Quote: strings.ToUpper(quote)}
+}
+
+// String renders the pair in canonical BASE/QUOTE form.
+func (p Pair) String() string {
+	return p.Base + "/" + p.Quote
+}
+
+// ParsePair parses a canonical pair string, accepting either "/" or "-" as
+// the base/quote separator (routers can't carry a literal "/" inside a
+// single path segment, so the API accepts "BTC-USDT" too).
+func ParsePair(s string) (Pair, error) {
+	for _, sep := range []string{"/", "-"} {
+		if i := strings.Index(s, sep); i > 0 && i < len(s)-1 {
+			return NewPair(s[:i], s[i+1:]), nil
+		}
+	}
+	return Pair{}, fmt.Errorf("symbol: cannot parse pair %q, expected BASE/QUOTE or BASE-QUOTE", s)
+}
+
+// MarshalJSON renders the pair as its canonical string form rather than a
+// {"Base":...,"Quote":...} object, so API responses stay human-readable.
+func (p Pair) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON parses the pair from its canonical string form.
+func (p *Pair) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := ParsePair(s)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
@@ -0,0 +1,48 @@
+package symbol
+
+import "testing"
+
+func TestNewPair(t *testing.T) {
+	pair := NewPair("btc", "Usdt")
+	if pair.Base != "BTC" || pair.Quote != "USDT" {
+		t.Errorf("NewPair(\"btc\", \"Usdt\") = %+v, want {BTC USDT}", pair)
+	}
+}
+
+func TestPairString(t *testing.T) {
+	pair := NewPair("BTC", "USDT")
+	if got := pair.String(); got != "BTC/USDT" {
+		t.Errorf("String() = %q, want %q", got, "BTC/USDT")
+	}
+}
+
+func TestParsePair(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Pair
+		wantErr bool
+	}{
+		{"BTC/USDT", NewPair("BTC", "USDT"), false},
+		{"btc-usdt", NewPair("BTC", "USDT"), false},
+		{"BTCUSDT", Pair{}, true},
+		{"/USDT", Pair{}, true},
+		{"BTC/", Pair{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePair(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParsePair(%q) = %v, want error", tt.input, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParsePair(%q) returned error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParsePair(%q) = %+v, want %+v", tt.input, got, tt.want)
+		}
+	}
+}